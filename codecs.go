@@ -0,0 +1,188 @@
+package quad
+
+import (
+	"compress/bzip2"
+	"compress/flate"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// Codec is a description for compression formats of quad files.
+type Codec struct {
+	// Name is a short codec name used as identifier for RegisterCodec.
+	Name string
+	// Ext is a list of file extensions, allowed for the codec. It's used to detect compression
+	// given a path with a compound extension, like ".nq.gz".
+	Ext []string
+	// Magic is a prefix of compressed streams. It's optional and used by DetectFormat.
+	Magic []byte
+	// Reader is a function for creating a decompressor for a stream.
+	Reader func(io.Reader) (io.ReadCloser, error)
+	// Writer is a function for creating a compressor for a stream. Can be nil if compression is not supported.
+	Writer func(io.Writer) (io.WriteCloser, error)
+}
+
+func init() {
+	RegisterCodec(Codec{
+		Name:  "gzip",
+		Ext:   []string{".gz"},
+		Magic: []byte{0x1f, 0x8b},
+		Reader: func(r io.Reader) (io.ReadCloser, error) {
+			return gzip.NewReader(r)
+		},
+		Writer: func(w io.Writer) (io.WriteCloser, error) {
+			return gzip.NewWriter(w), nil
+		},
+	})
+	RegisterCodec(Codec{
+		Name:  "bzip2",
+		Ext:   []string{".bz2"},
+		Magic: []byte("BZh"),
+		Reader: func(r io.Reader) (io.ReadCloser, error) {
+			return io.NopCloser(bzip2.NewReader(r)), nil
+		},
+	})
+	RegisterCodec(Codec{
+		Name: "flate",
+		Ext:  []string{".deflate"},
+		Reader: func(r io.Reader) (io.ReadCloser, error) {
+			return flate.NewReader(r), nil
+		},
+		Writer: func(w io.Writer) (io.WriteCloser, error) {
+			return flate.NewWriter(w, flate.DefaultCompression)
+		},
+	})
+}
+
+var (
+	codecsByName = make(map[string]*Codec)
+	codecsByExt  = make(map[string]*Codec)
+)
+
+// RegisterCodec registers a new compression codec.
+func RegisterCodec(c Codec) {
+	if _, ok := codecsByName[c.Name]; ok {
+		panic(fmt.Errorf("codec %s is already registered", c.Name))
+	}
+	codecsByName[c.Name] = &c
+	for _, e := range c.Ext {
+		if sc, ok := codecsByExt[e]; ok {
+			panic(fmt.Errorf("codec %s is already registered with extension %s", sc.Name, e))
+		}
+		codecsByExt[e] = &c
+	}
+}
+
+// CodecByName returns a registered codec by its name.
+// Will return nil if codec is not found.
+func CodecByName(name string) *Codec {
+	return codecsByName[name]
+}
+
+// CodecByExt returns a registered codec by its file extension.
+// Will return nil if codec is not found.
+func CodecByExt(name string) *Codec {
+	return codecsByExt[name]
+}
+
+// Codecs returns a list of all supported compression codecs.
+func Codecs() []Codec {
+	list := make([]Codec, 0, len(codecsByName))
+	for _, c := range codecsByName {
+		list = append(list, *c)
+	}
+	return list
+}
+
+// FormatByPath returns a registered format and a codec for a file path.
+// Path may have a compound extension, like ".nq.gz", in which case the codec is detected as well.
+// Codec is nil if the file is not compressed. Format is nil if format is not found.
+func FormatByPath(path string) (*Format, *Codec) {
+	ext := strings.ToLower(filepath.Ext(path))
+	c := CodecByExt(ext)
+	if c != nil {
+		path = strings.TrimSuffix(path, filepath.Ext(path))
+		ext = strings.ToLower(filepath.Ext(path))
+	}
+	return FormatByExt(ext), c
+}
+
+// NewCodecReader wraps a format reader with a decompressor. If codec is nil, the format reader is used as-is.
+func NewCodecReader(r io.Reader, f *Format, c *Codec) (ReadCloser, error) {
+	if f.Reader == nil {
+		return nil, fmt.Errorf("format %s does not support reading", f.Name)
+	} else if c == nil {
+		return f.Reader(r), nil
+	}
+	cr, err := c.Reader(r)
+	if err != nil {
+		return nil, err
+	}
+	return &codecReader{ReadCloser: f.Reader(cr), c: cr}, nil
+}
+
+// NewCodecWriter wraps a format writer with a compressor. If codec is nil, the format writer is used as-is.
+func NewCodecWriter(w io.Writer, f *Format, c *Codec) (WriteCloser, error) {
+	if f.Writer == nil {
+		return nil, fmt.Errorf("format %s does not support writing", f.Name)
+	} else if c == nil {
+		return f.Writer(w), nil
+	} else if c.Writer == nil {
+		return nil, fmt.Errorf("codec %s does not support compression", c.Name)
+	}
+	cw, err := c.Writer(w)
+	if err != nil {
+		return nil, err
+	}
+	return &codecWriter{WriteCloser: f.Writer(cw), c: cw}, nil
+}
+
+// NewPathReader creates a reader for a file with a given path, decompressing it if necessary.
+// Format and compression are detected from the file extension.
+func NewPathReader(r io.Reader, path string) (ReadCloser, error) {
+	f, c := FormatByPath(path)
+	if f == nil {
+		return nil, fmt.Errorf("unknown format for file %q", path)
+	}
+	return NewCodecReader(r, f, c)
+}
+
+// NewPathWriter creates a writer for a file with a given path, compressing it if necessary.
+// Format and compression are detected from the file extension.
+func NewPathWriter(w io.Writer, path string) (WriteCloser, error) {
+	f, c := FormatByPath(path)
+	if f == nil {
+		return nil, fmt.Errorf("unknown format for file %q", path)
+	}
+	return NewCodecWriter(w, f, c)
+}
+
+type codecReader struct {
+	ReadCloser
+	c io.Closer
+}
+
+func (r *codecReader) Close() error {
+	err := r.ReadCloser.Close()
+	if err2 := r.c.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
+
+type codecWriter struct {
+	WriteCloser
+	c io.Closer
+}
+
+func (w *codecWriter) Close() error {
+	// format writer must be flushed before the compressed stream is finalized
+	err := w.WriteCloser.Close()
+	if err2 := w.c.Close(); err == nil {
+		err = err2
+	}
+	return err
+}
@@ -0,0 +1,148 @@
+package quad
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+)
+
+// lineFormat is a test format that stores one subject IRI per line.
+var lineFormat = Format{
+	Name: "test-lines",
+	Ext:  []string{".lines"},
+	Reader: func(r io.Reader) ReadCloser {
+		var quads []Quad
+		sc := bufio.NewScanner(r)
+		for sc.Scan() {
+			quads = append(quads, Quad{Subject: IRI(sc.Text()), Predicate: IRI("p"), Object: IRI("o")})
+		}
+		return lineReader{NewReader(quads)}
+	},
+	Writer: func(w io.Writer) WriteCloser {
+		return &lineWriter{w: w}
+	},
+}
+
+type lineReader struct {
+	*Quads
+}
+
+func (lineReader) Close() error { return nil }
+
+type lineWriter struct {
+	w io.Writer
+}
+
+func (w *lineWriter) WriteQuad(q Quad) error {
+	_, err := io.WriteString(w.w, string(q.Subject.(IRI))+"\n")
+	return err
+}
+
+func (w *lineWriter) WriteQuads(buf []Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+func (w *lineWriter) Close() error { return nil }
+
+func init() {
+	RegisterFormat(lineFormat)
+}
+
+func TestFormatByPath(t *testing.T) {
+	cases := []struct {
+		path   string
+		format string
+		codec  string
+	}{
+		{"data.lines", "test-lines", ""},
+		{"/tmp/data.lines.gz", "test-lines", "gzip"},
+		{"data.LINES.BZ2", "test-lines", "bzip2"},
+		{"data.lines.deflate", "test-lines", "flate"},
+		{"data.gz", "", "gzip"},
+		{"data.unknown", "", ""},
+	}
+	for _, c := range cases {
+		f, cd := FormatByPath(c.path)
+		if (f == nil && c.format != "") || (f != nil && f.Name != c.format) {
+			t.Errorf("unexpected format for %q: %v", c.path, f)
+		}
+		if (cd == nil && c.codec != "") || (cd != nil && cd.Name != c.codec) {
+			t.Errorf("unexpected codec for %q: %v", c.path, cd)
+		}
+	}
+	if f := FormatByExt(".lines.gz"); f == nil || f.Name != "test-lines" {
+		t.Errorf("unexpected format for a compound extension: %v", f)
+	}
+}
+
+func TestCodecRoundTrip(t *testing.T) {
+	quads := []Quad{
+		{Subject: IRI("a"), Predicate: IRI("p"), Object: IRI("o")},
+		{Subject: IRI("b"), Predicate: IRI("p"), Object: IRI("o")},
+	}
+	for _, path := range []string{"data.lines", "data.lines.gz", "data.lines.deflate"} {
+		buf := bytes.NewBuffer(nil)
+		w, err := NewPathWriter(buf, path)
+		if err != nil {
+			t.Fatal(path, err)
+		}
+		if _, err = Copy(w, NewReader(quads)); err != nil {
+			t.Fatal(path, err)
+		} else if err = w.Close(); err != nil {
+			t.Fatal(path, err)
+		}
+		r, err := NewPathReader(buf, path)
+		if err != nil {
+			t.Fatal(path, err)
+		}
+		got, err := ReadAll(r)
+		if err != nil {
+			t.Fatal(path, err)
+		} else if err = r.Close(); err != nil {
+			t.Fatal(path, err)
+		}
+		if len(got) != len(quads) || got[0] != quads[0] || got[1] != quads[1] {
+			t.Errorf("unexpected quads for %q: %v", path, got)
+		}
+	}
+}
+
+func TestCodecErrors(t *testing.T) {
+	if _, err := NewPathWriter(io.Discard, "data.lines.bz2"); err == nil {
+		t.Error("expected an error for a codec without compression")
+	}
+	if _, err := NewPathReader(bytes.NewReader(nil), "data.unknown"); err == nil {
+		t.Error("expected an error for unknown format")
+	}
+	if _, err := NewPathReader(bytes.NewReader([]byte("not a gzip stream")), "data.lines.gz"); err != gzip.ErrHeader {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestRegisterCodec(t *testing.T) {
+	RegisterCodec(Codec{
+		Name: "test-identity",
+		Ext:  []string{".id"},
+		Reader: func(r io.Reader) (io.ReadCloser, error) {
+			return io.NopCloser(r), nil
+		},
+	})
+	if c := CodecByName("test-identity"); c == nil || CodecByExt(".id") != c {
+		t.Fatalf("codec was not registered: %v", c)
+	}
+	r, err := NewPathReader(bytes.NewReader([]byte("a\n")), "data.lines.id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := ReadAll(r)
+	if err != nil || len(got) != 1 {
+		t.Fatalf("unexpected result: %v, %v", got, err)
+	}
+}
@@ -0,0 +1,313 @@
+// Package csv provides an encoder and a decoder for quads in CSV and TSV formats.
+//
+// Each row holds subject, predicate, object and label columns, optionally followed by datatype and
+// language columns. Values are encoded the same way as in N-Quads. See RFC 4180 for the CSV definition.
+package csv
+
+import (
+	stdcsv "encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/term"
+)
+
+// AutoConvertTypedString allows to convert TypedString values to native
+// equivalents directly while parsing. It will call ToNative on all TypedString values.
+//
+// If conversion error occurs, it will preserve original TypedString value.
+var AutoConvertTypedString = true
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "csv",
+		Ext:    []string{".csv"},
+		Mime:   []string{"text/csv"},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w, &Options{Header: true}) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r, nil) },
+	})
+	quad.RegisterFormat(quad.Format{
+		Name:   "tsv",
+		Ext:    []string{".tsv"},
+		Mime:   []string{"text/tab-separated-values"},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w, &Options{Comma: '\t', Header: true}) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r, &ReaderOptions{Comma: '\t'}) },
+	})
+}
+
+// Column names, as written in a header row.
+const (
+	ColSubject   = "subject"
+	ColPredicate = "predicate"
+	ColObject    = "object"
+	ColLabel     = "label"
+	ColDatatype  = "datatype"
+	ColLanguage  = "language"
+)
+
+// columns is the default column order.
+var columns = []string{ColSubject, ColPredicate, ColObject, ColLabel, ColDatatype, ColLanguage}
+
+// ReaderOptions for the CSV decoder.
+type ReaderOptions struct {
+	// Comma is a field delimiter. Default is ','.
+	Comma rune
+	// Comment, if set, is a character that starts comment lines.
+	Comment rune
+}
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+// Reader implements CSV document parsing.
+//
+// If the first row consists of column names only, it is used as a header and columns are matched by names.
+// Otherwise, columns are expected in the default order: subject, predicate, object, label, datatype and language.
+// Label, datatype and language columns are optional. Datatype and language only apply to plain string objects.
+type Reader struct {
+	r    *stdcsv.Reader
+	cols map[string]int
+	err  error
+}
+
+// NewReader returns a CSV decoder that takes its input from the provided io.Reader.
+// Options can be nil.
+func NewReader(r io.Reader, opts *ReaderOptions) *Reader {
+	if opts == nil {
+		opts = &ReaderOptions{}
+	}
+	cr := stdcsv.NewReader(r)
+	if opts.Comma != 0 {
+		cr.Comma = opts.Comma
+	}
+	cr.Comment = opts.Comment
+	cr.FieldsPerRecord = -1
+	cr.ReuseRecord = true
+	return &Reader{r: cr}
+}
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	line, _ := r.r.FieldPos(0)
+	return fmt.Errorf("line %d: "+format, append([]interface{}{line}, args...)...)
+}
+
+// header checks if a row is a header and sets column indexes.
+func (r *Reader) header(row []string) bool {
+	cols := make(map[string]int, len(row))
+	for i, name := range row {
+		name = strings.ToLower(strings.TrimSpace(name))
+		known := false
+		for _, c := range columns {
+			known = known || c == name
+		}
+		if !known {
+			return false
+		} else if _, ok := cols[name]; ok {
+			return false
+		}
+		cols[name] = i
+	}
+	r.cols = cols
+	return true
+}
+
+// cell returns a value of the column, or an empty string.
+func (r *Reader) cell(row []string, name string) string {
+	if i, ok := r.cols[name]; ok && i < len(row) {
+		return strings.TrimSpace(row[i])
+	}
+	return ""
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	if r.err != nil {
+		return quad.Quad{}, r.err
+	}
+	q, err := r.readQuad()
+	r.err = err
+	return q, err
+}
+
+func (r *Reader) readQuad() (quad.Quad, error) {
+	row, err := r.r.Read()
+	if err != nil {
+		return quad.Quad{}, err
+	}
+	if r.cols == nil {
+		if r.header(row) {
+			if row, err = r.r.Read(); err != nil {
+				return quad.Quad{}, err
+			}
+		} else {
+			r.cols = make(map[string]int, len(columns))
+			for i, c := range columns {
+				r.cols[c] = i
+			}
+		}
+	}
+	var (
+		q    quad.Quad
+		vals = []*quad.Value{&q.Subject, &q.Predicate, &q.Object, &q.Label}
+	)
+	for i, name := range columns[:len(vals)] {
+		s := r.cell(row, name)
+		if s == "" {
+			if name == ColLabel {
+				continue
+			}
+			return quad.Quad{}, r.errorf("empty %s", name)
+		}
+		v, err := term.Parse(s)
+		if err != nil {
+			return quad.Quad{}, r.errorf("invalid %s: %w", name, err)
+		}
+		*vals[i] = v
+	}
+	dt, lang := r.cell(row, ColDatatype), r.cell(row, ColLanguage)
+	if dt == "" && lang == "" {
+		return q, nil
+	}
+	s, ok := q.Object.(quad.String)
+	if !ok {
+		return quad.Quad{}, r.errorf("datatype or language set for a non-string object: %v", q.Object)
+	} else if dt != "" && lang != "" {
+		return quad.Quad{}, r.errorf("both datatype and language are set")
+	} else if lang != "" {
+		q.Object = quad.LangString{Value: s, Lang: lang}
+		return q, nil
+	}
+	v := quad.TypedString{Value: s, Type: quad.IRI(strings.TrimSuffix(strings.TrimPrefix(dt, "<"), ">"))}
+	q.Object = v
+	if AutoConvertTypedString {
+		if nv, err := v.ParseValue(); err == nil {
+			q.Object = nv
+		}
+	}
+	return q, nil
+}
+
+// ReadQuads implements quad.BatchReader.
+func (r *Reader) ReadQuads(buf []quad.Quad) (int, error) {
+	for i := range buf {
+		q, err := r.ReadQuad()
+		if err != nil {
+			return i, err
+		}
+		buf[i] = q
+	}
+	return len(buf), nil
+}
+
+// Close implements quad.ReadCloser.
+func (r *Reader) Close() error { return nil }
+
+// Options for the CSV encoder.
+type Options struct {
+	// Comma is a field delimiter. Default is ','.
+	Comma rune
+	// Header enables writing a header row.
+	Header bool
+	// Types enables writing datatype and language columns. Typed and language-tagged literals
+	// are then written as plain strings in the object column.
+	Types bool
+}
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns a CSV encoder that writes its output to the provided io.Writer.
+// Options can be nil.
+func NewWriter(w io.Writer, opts *Options) *Writer {
+	if opts == nil {
+		opts = &Options{}
+	}
+	cw := stdcsv.NewWriter(w)
+	if opts.Comma != 0 {
+		cw.Comma = opts.Comma
+	}
+	n := 4
+	if opts.Types {
+		n = 6
+	}
+	return &Writer{w: cw, opts: *opts, row: make([]string, n)}
+}
+
+// Writer implements CSV document generator.
+type Writer struct {
+	w       *stdcsv.Writer
+	opts    Options
+	row     []string
+	written bool
+	err     error
+}
+
+func (w *Writer) writeHeader() {
+	if w.written {
+		return
+	}
+	w.written = true
+	if w.opts.Header {
+		w.err = w.w.Write(columns[:len(w.row)])
+	}
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	}
+	if w.writeHeader(); w.err != nil {
+		return w.err
+	}
+	for i := range w.row {
+		w.row[i] = ""
+	}
+	o := q.Object
+	if w.opts.Types {
+		if ts, ok := o.(quad.TypedStringer); ok {
+			o = ts.TypedString()
+		}
+		switch v := o.(type) {
+		case quad.TypedString:
+			o, w.row[4] = v.Value, string(v.Type.Full())
+		case quad.LangString:
+			o, w.row[5] = v.Value, v.Lang
+		}
+	}
+	w.row[0], w.row[1], w.row[2] = q.Subject.String(), q.Predicate.String(), o.String()
+	if q.Label != nil {
+		w.row[3] = q.Label.String()
+	}
+	w.err = w.w.Write(w.row)
+	return w.err
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+// Close writes a header row if no quads were written and flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.writeHeader()
+	if w.err == nil {
+		w.w.Flush()
+		w.err = w.w.Error()
+	}
+	if w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	return nil
+}
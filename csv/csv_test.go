@@ -0,0 +1,194 @@
+package csv_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/csv"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+var testQuads = []quad.Quad{
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")},
+	{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String(`Alice "A", Smith`), Label: iri("graph")},
+	{Subject: iri("alice"), Predicate: iri("name"), Object: quad.LangString{Value: "Alicia", Lang: "es"}},
+	{Subject: iri("alice"), Predicate: iri("age"), Object: quad.Int(42)},
+	{Subject: iri("alice"), Predicate: iri("born"), Object: quad.Time(time.Date(1990, 7, 4, 0, 0, 0, 0, time.UTC))},
+	{Subject: iri("alice"), Predicate: iri("code"), Object: quad.TypedString{Value: "x1", Type: iri("Code")}},
+}
+
+func write(t *testing.T, opts *csv.Options) string {
+	buf := bytes.NewBuffer(nil)
+	w := csv.NewWriter(buf, opts)
+	_, err := quad.Copy(w, quad.NewReader(testQuads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.String()
+}
+
+func TestWriter(t *testing.T) {
+	require.Equal(t, `subject,predicate,object,label
+<http://example.org/alice>,<http://example.org/knows>,_:bob,
+<http://example.org/alice>,<http://example.org/name>,"""Alice \""A\"", Smith""",<http://example.org/graph>
+<http://example.org/alice>,<http://example.org/name>,"""Alicia""@es",
+<http://example.org/alice>,<http://example.org/age>,"""42""^^<xsd:integer>",
+<http://example.org/alice>,<http://example.org/born>,"""1990-07-04T00:00:00Z""^^<xsd:dateTime>",
+<http://example.org/alice>,<http://example.org/code>,"""x1""^^<http://example.org/Code>",
+`, write(t, &csv.Options{Header: true}))
+
+	require.Equal(t, "<http://example.org/alice>\t<http://example.org/knows>\t_:bob\t\t\t\n"+
+		"<http://example.org/alice>\t<http://example.org/name>\t\"\"\"Alice \\\"\"A\\\"\", Smith\"\"\"\t<http://example.org/graph>\t\t\n"+
+		"<http://example.org/alice>\t<http://example.org/name>\t\"\"\"Alicia\"\"\"\t\t\tes\n"+
+		"<http://example.org/alice>\t<http://example.org/age>\t\"\"\"42\"\"\"\t\thttp://www.w3.org/2001/XMLSchema#integer\t\n"+
+		"<http://example.org/alice>\t<http://example.org/born>\t\"\"\"1990-07-04T00:00:00Z\"\"\"\t\thttp://www.w3.org/2001/XMLSchema#dateTime\t\n"+
+		"<http://example.org/alice>\t<http://example.org/code>\t\"\"\"x1\"\"\"\t\thttp://example.org/Code\t\n",
+		write(t, &csv.Options{Comma: '\t', Types: true}))
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, opts := range []struct {
+		w *csv.Options
+		r *csv.ReaderOptions
+	}{
+		{w: &csv.Options{Header: true}},
+		{w: &csv.Options{}},
+		{w: &csv.Options{Header: true, Types: true}},
+		{w: &csv.Options{Comma: ';', Types: true}, r: &csv.ReaderOptions{Comma: ';'}},
+		{w: &csv.Options{Comma: '\t', Header: true}, r: &csv.ReaderOptions{Comma: '\t'}},
+	} {
+		data := write(t, opts.w)
+		got, err := quad.ReadAll(csv.NewReader(bytes.NewBufferString(data), opts.r))
+		require.NoError(t, err, data)
+		require.Len(t, got, len(testQuads))
+		for i, q := range testQuads {
+			require.Equal(t, q.String(), got[i].String(), data)
+		}
+	}
+}
+
+func TestReader(t *testing.T) {
+	const data = `# exported from a spreadsheet
+Language,Object,Subject,Predicate,Datatype
+en,"""Bob""",_:bob,<http://example.org/name>,
+,"""7""",_:bob,<http://example.org/age>,<http://www.w3.org/2001/XMLSchema#integer>
+, _:alice ,_:bob,<http://example.org/knows>,
+`
+	got, err := quad.ReadAll(csv.NewReader(bytes.NewBufferString(data), &csv.ReaderOptions{Comment: '#'}))
+	require.NoError(t, err)
+	require.Equal(t, []quad.Quad{
+		{Subject: quad.BNode("bob"), Predicate: iri("name"), Object: quad.LangString{Value: "Bob", Lang: "en"}},
+		{Subject: quad.BNode("bob"), Predicate: iri("age"), Object: quad.Int(7)},
+		{Subject: quad.BNode("bob"), Predicate: iri("knows"), Object: quad.BNode("alice")},
+	}, got)
+}
+
+func TestReaderErrors(t *testing.T) {
+	for _, data := range []string{
+		"_:a,<p>\n",
+		"_:a,<p>,\n",
+		"_:a,<p>,<o,\n",
+		"_:a,<p>,o p,\n",
+		"_:a,<p>,<o>,,<http://example.org/T>\n",
+		"_:a,<p>,\"o\",,<http://example.org/T>,en\n",
+		"_:a,<p>,\"o\n",
+	} {
+		_, err := quad.ReadAll(csv.NewReader(bytes.NewBufferString(data), nil))
+		require.Error(t, err, data)
+	}
+}
+
+func TestFormats(t *testing.T) {
+	for _, name := range []string{"csv", "tsv"} {
+		f := quad.FormatByName(name)
+		require.NotNil(t, f)
+		buf := bytes.NewBuffer(nil)
+		w := f.Writer(buf)
+		_, err := quad.Copy(w, quad.NewReader(testQuads))
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+		got, err := quad.ReadAll(f.Reader(buf))
+		require.NoError(t, err)
+		require.Len(t, got, len(testQuads))
+	}
+}
+
+const people = `id,name,age,city,tags,born
+1,Alice Smith,42,New York,a; b,1990-07-04T00:00:00Z
+2,Bob,n/a,,c,
+`
+
+func TestMappingReader(t *testing.T) {
+	var m csv.Mapping
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"subject": "http://example.org/person/{id}",
+		"graph": "http://example.org/people",
+		"columns": [
+			{"column": "name", "predicate": "http://schema.org/name", "lang": "en"},
+			{"column": "age", "predicate": "http://schema.org/age", "datatype": "http://www.w3.org/2001/XMLSchema#integer", "null": "n/a"},
+			{"column": "city", "predicate": "http://schema.org/city", "object": "http://example.org/city/{city}"},
+			{"column": "tags", "predicate": "http://schema.org/keywords", "separator": ";"},
+			{"column": "born", "predicate": "http://schema.org/birthDate", "datatype": "xsd:dateTime"},
+			{"column": "id", "predicate": "http://schema.org/position", "object": "http://example.org/row/{_row}"}
+		]
+	}`), &m))
+	r := csv.NewMappingReader(bytes.NewBufferString(people), &m, nil)
+	defer r.Close()
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	alice, bob, g := quad.IRI("http://example.org/person/1"), quad.IRI("http://example.org/person/2"), quad.IRI("http://example.org/people")
+	require.Equal(t, []quad.Quad{
+		{Subject: alice, Predicate: quad.IRI("http://schema.org/name"), Object: quad.LangString{Value: "Alice Smith", Lang: "en"}, Label: g},
+		{Subject: alice, Predicate: quad.IRI("http://schema.org/age"), Object: quad.Int(42), Label: g},
+		{Subject: alice, Predicate: quad.IRI("http://schema.org/city"), Object: quad.IRI("http://example.org/city/New%20York"), Label: g},
+		{Subject: alice, Predicate: quad.IRI("http://schema.org/keywords"), Object: quad.String("a"), Label: g},
+		{Subject: alice, Predicate: quad.IRI("http://schema.org/keywords"), Object: quad.String("b"), Label: g},
+		{Subject: alice, Predicate: quad.IRI("http://schema.org/birthDate"), Object: quad.Time(time.Date(1990, 7, 4, 0, 0, 0, 0, time.UTC)), Label: g},
+		{Subject: alice, Predicate: quad.IRI("http://schema.org/position"), Object: quad.IRI("http://example.org/row/1"), Label: g},
+		{Subject: bob, Predicate: quad.IRI("http://schema.org/name"), Object: quad.LangString{Value: "Bob", Lang: "en"}, Label: g},
+		{Subject: bob, Predicate: quad.IRI("http://schema.org/keywords"), Object: quad.String("c"), Label: g},
+		{Subject: bob, Predicate: quad.IRI("http://schema.org/position"), Object: quad.IRI("http://example.org/row/2"), Label: g},
+	}, got)
+}
+
+func TestMappingReaderNames(t *testing.T) {
+	m := &csv.Mapping{
+		Names:   []string{"name", "score"},
+		Columns: []csv.ColumnMapping{{Column: "score", Predicate: iri("score"), Datatype: "http://www.w3.org/2001/XMLSchema#double"}},
+	}
+	const data = "a\t0.5\nb\t1\n"
+	got, err := quad.ReadAll(csv.NewMappingReader(bytes.NewBufferString(data), m, &csv.ReaderOptions{Comma: '\t'}))
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	row1, row2 := got[0].Subject, got[1].Subject
+	require.NotEqual(t, row1, row2)
+	require.Equal(t, []quad.Quad{
+		{Subject: row1, Predicate: iri("score"), Object: quad.Float(0.5)},
+		{Subject: row2, Predicate: iri("score"), Object: quad.Float(1)},
+	}, got)
+
+	// rows of different tables are distinct
+	again, err := quad.ReadAll(csv.NewMappingReader(bytes.NewBufferString(data), m, &csv.ReaderOptions{Comma: '\t'}))
+	require.NoError(t, err)
+	require.NotEqual(t, row1, again[0].Subject)
+}
+
+func TestMappingReaderErrors(t *testing.T) {
+	for _, m := range []csv.Mapping{
+		{Columns: []csv.ColumnMapping{{Column: "missing", Predicate: iri("p")}}},
+		{Columns: []csv.ColumnMapping{{Column: "name"}}},
+		{Columns: []csv.ColumnMapping{{Column: "name", Predicate: iri("p"), Lang: "en", Datatype: iri("T")}}},
+		{Subject: "http://example.org/{missing}"},
+		{Subject: "http://example.org/{id"},
+		{Subject: "http://example.org/{city}", Columns: []csv.ColumnMapping{{Column: "name", Predicate: iri("p")}}},
+		{Columns: []csv.ColumnMapping{{Column: "age", Predicate: iri("p"), Datatype: "http://www.w3.org/2001/XMLSchema#integer"}}},
+	} {
+		_, err := quad.ReadAll(csv.NewMappingReader(bytes.NewBufferString(people), &m, nil))
+		require.Error(t, err, "%+v", m)
+	}
+}
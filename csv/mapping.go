@@ -0,0 +1,261 @@
+package csv
+
+import (
+	stdcsv "encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/bnode"
+	"github.com/cayleygraph/quad/internal/iritemplate"
+)
+
+// Mapping describes how rows of a CSV table are converted to quads, loosely following CSVW and RML.
+//
+// Templates are IRI templates where column names in braces are replaced with percent-encoded
+// cell values, for example "http://example.org/person/{id}". A special "_row" name refers to the
+// row number, starting from 1. Mapping can be decoded from JSON.
+type Mapping struct {
+	// Subject is a template for subjects of rows. If it's not set, rows are generated blank nodes.
+	Subject string `json:"subject,omitempty"`
+	// Graph is a label for all quads. Optional.
+	Graph quad.IRI `json:"graph,omitempty"`
+	// Names are column names for tables without a header row. If not set, the first row is a header.
+	Names []string `json:"names,omitempty"`
+	// Columns define properties of row subjects. Columns without a mapping are skipped.
+	Columns []ColumnMapping `json:"columns"`
+}
+
+// ColumnMapping describes how cells of a column are converted to objects.
+type ColumnMapping struct {
+	// Column is a name of the column.
+	Column string `json:"column"`
+	// Predicate for values of the column.
+	Predicate quad.IRI `json:"predicate"`
+	// Object is a template for IRI objects. If it's set, cells are not used as literals.
+	Object string `json:"object,omitempty"`
+	// Datatype of literals. Values are converted to native types with registered string conversions.
+	Datatype quad.IRI `json:"datatype,omitempty"`
+	// Lang is a language tag of string literals.
+	Lang string `json:"lang,omitempty"`
+	// Separator, if set, splits cells into multiple values.
+	Separator string `json:"separator,omitempty"`
+	// Null is a cell value that means no value. Empty cells are always skipped.
+	Null string `json:"null,omitempty"`
+}
+
+const rowName = "_row"
+
+// template is a parsed IRI template with column references.
+type template struct {
+	*iritemplate.Template
+	cols []int // -1 for the row number
+}
+
+func parseTemplate(s string, index map[string]int) (*template, error) {
+	it, err := iritemplate.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	t := &template{Template: it}
+	for _, name := range it.Exprs {
+		col, ok := index[name]
+		if name == rowName {
+			col = -1
+		} else if !ok {
+			return nil, fmt.Errorf("unknown column in template: %q", name)
+		}
+		t.cols = append(t.cols, col)
+	}
+	return t, nil
+}
+
+var errEmpty = errors.New("empty cell")
+
+// expand builds an IRI from a row. It returns false if any referenced cell is empty.
+func (t *template) expand(row []string, n int) (quad.IRI, bool) {
+	iri, err := t.Expand(func(i int) (string, error) {
+		var s string
+		if col := t.cols[i]; col < 0 {
+			s = strconv.Itoa(n)
+		} else if col < len(row) {
+			s = strings.TrimSpace(row[col])
+		}
+		if s == "" {
+			return "", errEmpty
+		}
+		return s, nil
+	})
+	return iri, err == nil
+}
+
+// column is a compiled column mapping.
+type column struct {
+	ColumnMapping
+	index  int
+	object *template
+}
+
+var _ quad.ReadCloser = (*MappingReader)(nil)
+
+// MappingReader converts rows of a CSV table to quads according to a Mapping.
+type MappingReader struct {
+	r    *stdcsv.Reader
+	m    Mapping
+	err  error
+	rows int
+	seq  bnode.Generator
+
+	subject *template
+	cols    []column
+	buf     []quad.Quad
+}
+
+// NewMappingReader returns a decoder that converts CSV rows from the provided io.Reader to quads,
+// according to the mapping. Options can be nil.
+func NewMappingReader(r io.Reader, m *Mapping, opts *ReaderOptions) *MappingReader {
+	mr := &MappingReader{r: NewReader(r, opts).r, m: *m}
+	if len(m.Names) != 0 {
+		mr.err = mr.compile(m.Names)
+	}
+	return mr
+}
+
+func (r *MappingReader) errorf(format string, args ...interface{}) error {
+	line, _ := r.r.FieldPos(0)
+	return fmt.Errorf("line %d: "+format, append([]interface{}{line}, args...)...)
+}
+
+// compile resolves column names of the mapping.
+func (r *MappingReader) compile(names []string) error {
+	index := make(map[string]int, len(names))
+	for i, name := range names {
+		index[strings.TrimSpace(name)] = i
+	}
+	if r.m.Subject != "" {
+		t, err := parseTemplate(r.m.Subject, index)
+		if err != nil {
+			return fmt.Errorf("subject: %w", err)
+		}
+		r.subject = t
+	}
+	r.cols = make([]column, 0, len(r.m.Columns))
+	for _, c := range r.m.Columns {
+		i, ok := index[c.Column]
+		if !ok {
+			return fmt.Errorf("unknown column: %q", c.Column)
+		} else if c.Predicate == "" {
+			return fmt.Errorf("column %q: no predicate", c.Column)
+		} else if c.Datatype != "" && c.Lang != "" {
+			return fmt.Errorf("column %q: both datatype and language are set", c.Column)
+		}
+		col := column{ColumnMapping: c, index: i}
+		if c.Object != "" {
+			t, err := parseTemplate(c.Object, index)
+			if err != nil {
+				return fmt.Errorf("column %q: %w", c.Column, err)
+			}
+			col.object = t
+		}
+		r.cols = append(r.cols, col)
+	}
+	return nil
+}
+
+// literal converts a cell value to a literal.
+func (c *column) literal(s string) (quad.Value, error) {
+	if c.Lang != "" {
+		return quad.LangString{Value: quad.String(s), Lang: c.Lang}, nil
+	} else if c.Datatype == "" {
+		return quad.String(s), nil
+	}
+	return quad.TypedString{Value: quad.String(s), Type: c.Datatype}.ParseValue()
+}
+
+// next converts a single row to quads.
+func (r *MappingReader) next() error {
+	row, err := r.r.Read()
+	if err != nil {
+		return err
+	}
+	if r.cols == nil {
+		return r.compile(row)
+	}
+	r.rows++
+	var s quad.Value = r.seq.Next()
+	if r.subject != nil {
+		iri, ok := r.subject.expand(row, r.rows)
+		if !ok {
+			return r.errorf("empty subject")
+		}
+		s = iri
+	}
+	var label quad.Value
+	if r.m.Graph != "" {
+		label = r.m.Graph
+	}
+	for i := range r.cols {
+		c := &r.cols[i]
+		var cell string
+		if c.index < len(row) {
+			cell = strings.TrimSpace(row[c.index])
+		}
+		if cell == "" || (c.Null != "" && cell == c.Null) {
+			continue
+		}
+		vals := []string{cell}
+		if c.Separator != "" {
+			vals = strings.Split(cell, c.Separator)
+		}
+		for _, v := range vals {
+			if v = strings.TrimSpace(v); v == "" {
+				continue
+			}
+			var o quad.Value
+			if c.object != nil {
+				parts := append([]string{}, row...)
+				parts[c.index] = v
+				iri, ok := c.object.expand(parts, r.rows)
+				if !ok {
+					continue
+				}
+				o = iri
+			} else if o, err = c.literal(v); err != nil {
+				return r.errorf("column %q: %w", c.Column, err)
+			}
+			r.buf = append(r.buf, quad.Quad{Subject: s, Predicate: c.Predicate, Object: o, Label: label})
+		}
+	}
+	return nil
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *MappingReader) ReadQuad() (quad.Quad, error) {
+	for len(r.buf) == 0 && r.err == nil {
+		r.err = r.next()
+	}
+	if len(r.buf) != 0 {
+		q := r.buf[0]
+		r.buf = r.buf[1:]
+		return q, nil
+	}
+	return quad.Quad{}, r.err
+}
+
+// ReadQuads implements quad.BatchReader.
+func (r *MappingReader) ReadQuads(buf []quad.Quad) (int, error) {
+	for i := range buf {
+		q, err := r.ReadQuad()
+		if err != nil {
+			return i, err
+		}
+		buf[i] = q
+	}
+	return len(buf), nil
+}
+
+// Close implements quad.ReadCloser.
+func (r *MappingReader) Close() error { return nil }
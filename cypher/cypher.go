@@ -0,0 +1,383 @@
+// Package cypher provides an encoder for Cypher scripts that load quads into a property graph database.
+//
+// See https://opencypher.org/resources/ for the language definition.
+package cypher
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/pgraph"
+)
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "cypher",
+		Ext:    []string{".cypher"},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w, nil) },
+	})
+}
+
+// DefaultLabel is a node label used to match nodes by IRIs.
+const DefaultLabel = "Resource"
+
+// IRIProperty is a node property that holds IRIs of nodes.
+const IRIProperty = "iri"
+
+// Property types, used to coerce values of lists to a single type.
+const (
+	typeString   = "string"
+	typeLong     = "long"
+	typeDouble   = "double"
+	typeBoolean  = "boolean"
+	typeDateTime = "datetime"
+)
+
+// Options for the Cypher encoder.
+type Options struct {
+	// BatchSize is the maximal number of rows in a single statement. Default is quad.DefaultBatch.
+	BatchSize int
+	// Label is set on all nodes. Default is DefaultLabel.
+	Label string
+}
+
+// node is a node with its properties.
+type node struct {
+	iri   string
+	keys  []string // property names in order of appearance
+	props map[string][]quad.Value
+}
+
+// edges is a set of edges with the same predicate.
+type edges struct {
+	typ  string
+	rows []string
+}
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns a Cypher encoder that writes its output to the provided io.Writer.
+// Options can be nil.
+func NewWriter(w io.Writer, opts *Options) *Writer {
+	if opts == nil {
+		opts = &Options{}
+	}
+	o := *opts
+	if o.BatchSize <= 0 {
+		o.BatchSize = quad.DefaultBatch
+	}
+	if o.Label == "" {
+		o.Label = DefaultLabel
+	}
+	return &Writer{
+		w: bufio.NewWriter(w), opts: o,
+		nodes: make(map[string]*node),
+		preds: make(map[string]*edges),
+		seen:  make(map[string]struct{}),
+	}
+}
+
+// Writer implements Cypher script generator.
+//
+// The script uses MERGE, so it can be run against a database that already holds some of the nodes.
+// IRIs and blank nodes are merged as nodes with the label from Options, matched by the IRIProperty property.
+// Literal properties are set on these nodes, named by shortened predicate IRIs. Several values of the same
+// property become a list. Neo4j requires lists to hold values of one type, so integers are converted to floats
+// if mixed with floats, and other mixed values are converted to strings. Quads with IRI or blank node objects
+// are merged as relationships, typed by shortened predicate IRIs. Quad labels have no counterpart in Cypher.
+//
+// Statements are written on Close: first all nodes, so that relationships can MATCH both ends, and then
+// relationships, grouped by type. Each UNWIND statement holds up to BatchSize rows.
+type Writer struct {
+	w    *bufio.Writer
+	opts Options
+	err  error
+
+	order []*node
+	nodes map[string]*node
+	list  []*edges // predicates in order of appearance
+	preds map[string]*edges
+	seen  map[string]struct{} // edges
+}
+
+// name returns a property name or a relationship type for a predicate.
+func name(v quad.Value) string {
+	if iri, ok := v.(quad.IRI); ok {
+		return string(iri.Short())
+	}
+	return quad.ToString(v)
+}
+
+// Escape returns a Cypher string literal.
+func Escape(s string) string {
+	var sb strings.Builder
+	sb.WriteByte('"')
+	for _, r := range s {
+		switch r {
+		case '"':
+			sb.WriteString(`\"`)
+		case '\\':
+			sb.WriteString(`\\`)
+		case '\n':
+			sb.WriteString(`\n`)
+		case '\r':
+			sb.WriteString(`\r`)
+		case '\t':
+			sb.WriteString(`\t`)
+		case '\b':
+			sb.WriteString(`\b`)
+		case '\f':
+			sb.WriteString(`\f`)
+		default:
+			if r < 0x20 || r == 0x7f {
+				fmt.Fprintf(&sb, `\u%04X`, r)
+			} else {
+				sb.WriteRune(r)
+			}
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+// EscapeName returns a Cypher identifier, quoting it with backticks if necessary.
+func EscapeName(s string) string {
+	simple := s != ""
+	for i, r := range s {
+		if !(r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (i != 0 && r >= '0' && r <= '9')) {
+			simple = false
+			break
+		}
+	}
+	if simple {
+		return s
+	}
+	return "`" + strings.ReplaceAll(s, "`", "``") + "`"
+}
+
+// literal returns a Cypher expression for a literal.
+func literal(v quad.Value) string {
+	switch v := v.(type) {
+	case quad.Int:
+		return strconv.FormatInt(int64(v), 10)
+	case quad.Float:
+		f := float64(v)
+		switch {
+		case math.IsNaN(f):
+			return "0.0/0.0"
+		case math.IsInf(f, 1):
+			return "1.0/0.0"
+		case math.IsInf(f, -1):
+			return "-1.0/0.0"
+		}
+		s := strconv.FormatFloat(f, 'g', -1, 64)
+		if !strings.ContainsAny(s, ".e") {
+			s += ".0"
+		}
+		return s
+	case quad.Bool:
+		return strconv.FormatBool(bool(v))
+	case quad.Time:
+		return "datetime(" + Escape(time.Time(v).Format(time.RFC3339Nano)) + ")"
+	case quad.LangString:
+		return Escape(string(v.Value))
+	case quad.TypedString:
+		return Escape(string(v.Value))
+	}
+	return Escape(quad.ToString(v))
+}
+
+// kind returns a property type of a literal.
+func kind(v quad.Value) string {
+	switch v.(type) {
+	case quad.Int:
+		return typeLong
+	case quad.Float:
+		return typeDouble
+	case quad.Bool:
+		return typeBoolean
+	case quad.Time:
+		return typeDateTime
+	}
+	return typeString
+}
+
+// merge returns a type of a list that holds values of both types.
+func merge(a, b string) string {
+	switch {
+	case a == b:
+		return a
+	case (a == typeLong && b == typeDouble) || (a == typeDouble && b == typeLong):
+		return typeDouble
+	}
+	return typeString
+}
+
+// coerce returns a Cypher expression for a literal, converted to the given type.
+func coerce(v quad.Value, typ string) string {
+	if typ == kind(v) {
+		return literal(v)
+	}
+	switch v := v.(type) {
+	case quad.Int:
+		if typ == typeDouble {
+			return literal(quad.Float(v))
+		}
+		return Escape(strconv.FormatInt(int64(v), 10))
+	case quad.Float:
+		return Escape(strconv.FormatFloat(float64(v), 'g', -1, 64))
+	case quad.Bool:
+		return Escape(strconv.FormatBool(bool(v)))
+	case quad.Time:
+		return Escape(time.Time(v).Format(time.RFC3339Nano))
+	}
+	return literal(v)
+}
+
+// node returns a node for a value, adding it if necessary.
+func (w *Writer) node(v quad.Value) *node {
+	id := pgraph.NodeID(v)
+	n, ok := w.nodes[id]
+	if !ok {
+		n = &node{iri: id}
+		w.nodes[id] = n
+		w.order = append(w.order, n)
+	}
+	return n
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	} else if _, ok := q.Subject.(quad.Triple); ok {
+		return fmt.Errorf("quoted triples are not supported: %v", q.Subject)
+	}
+	n := w.node(q.Subject)
+	if pgraph.IsNode(q.Object) {
+		o := w.node(q.Object)
+		typ := name(q.Predicate)
+		e := w.preds[typ]
+		if e == nil {
+			e = &edges{typ: typ}
+			w.preds[typ] = e
+			w.list = append(w.list, e)
+		}
+		row := "[" + Escape(n.iri) + ", " + Escape(o.iri) + "]"
+		if _, ok := w.seen[typ+" "+row]; !ok {
+			w.seen[typ+" "+row] = struct{}{}
+			e.rows = append(e.rows, row)
+		}
+		return nil
+	}
+	key := name(q.Predicate)
+	if n.props == nil {
+		n.props = make(map[string][]quad.Value)
+	}
+	if _, ok := n.props[key]; !ok {
+		n.keys = append(n.keys, key)
+	}
+	n.props[key] = append(n.props[key], q.Object)
+	return nil
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+func (w *Writer) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.w.WriteString(s)
+}
+
+// nodeRow returns a map literal with an IRI and properties of a node.
+func nodeRow(n *node) string {
+	var sb strings.Builder
+	sb.WriteString("{" + IRIProperty + ": " + Escape(n.iri) + ", props: {")
+	for i, k := range n.keys {
+		if i != 0 {
+			sb.WriteString(", ")
+		}
+		sb.WriteString(EscapeName(k) + ": ")
+		vals := n.props[k]
+		if len(vals) == 1 {
+			sb.WriteString(literal(vals[0]))
+			continue
+		}
+		typ := kind(vals[0])
+		for _, v := range vals[1:] {
+			typ = merge(typ, kind(v))
+		}
+		sb.WriteString("[")
+		for j, v := range vals {
+			if j != 0 {
+				sb.WriteString(", ")
+			}
+			sb.WriteString(coerce(v, typ))
+		}
+		sb.WriteString("]")
+	}
+	sb.WriteString("}}")
+	return sb.String()
+}
+
+// writeBatches writes rows in UNWIND statements, followed by the body of the statement.
+func (w *Writer) writeBatches(rows []string, body string) {
+	for len(rows) != 0 {
+		n := len(rows)
+		if n > w.opts.BatchSize {
+			n = w.opts.BatchSize
+		}
+		w.writeString("UNWIND [\n")
+		for i, r := range rows[:n] {
+			if i != 0 {
+				w.writeString(",\n")
+			}
+			w.writeString("\t" + r)
+		}
+		w.writeString("\n] AS row\n" + body + ";\n")
+		rows = rows[n:]
+	}
+}
+
+// Close writes the script and flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	label := EscapeName(w.opts.Label)
+	prop := EscapeName(IRIProperty)
+	rows := make([]string, 0, len(w.order))
+	for _, n := range w.order {
+		rows = append(rows, nodeRow(n))
+	}
+	w.writeBatches(rows, "MERGE (n:"+label+" {"+prop+": row."+IRIProperty+"}) SET n += row.props")
+	for _, e := range w.list {
+		w.writeBatches(e.rows, "MATCH (s:"+label+" {"+prop+": row[0]}), (o:"+label+" {"+prop+": row[1]})\n"+
+			"MERGE (s)-[:"+EscapeName(e.typ)+"]->(o)")
+	}
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	if w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	return nil
+}
@@ -0,0 +1,66 @@
+package cypher_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/cypher"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+func TestWriter(t *testing.T) {
+	quads := []quad.Quad{
+		{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String("Alice \"A\"\n\\")},
+		{Subject: iri("alice"), Predicate: iri("age"), Object: quad.Int(30)},
+		{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")},
+		{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("score"), Object: quad.Float(2)},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("score"), Object: quad.Float(2.5)},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("score"), Object: quad.Int(3)},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("ok"), Object: quad.Bool(true)},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("tag"), Object: quad.Int(30)},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("tag"), Object: quad.String("x")},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("born"), Object: quad.Time(time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC))},
+		{Subject: quad.BNode("bob"), Predicate: iri("likes"), Object: iri("carol"), Label: iri("g")},
+		{Subject: iri("carol"), Predicate: iri("knows"), Object: iri("alice")},
+	}
+	var buf bytes.Buffer
+	w := cypher.NewWriter(&buf, &cypher.Options{BatchSize: 2})
+	n, err := w.WriteQuads(quads)
+	require.NoError(t, err)
+	require.Equal(t, len(quads), n)
+	require.NoError(t, w.Close())
+	require.Equal(t, `UNWIND [
+	{iri: "http://example.org/alice", props: {`+"`http://example.org/name`"+`: "Alice \"A\"\n\\", `+"`http://example.org/age`"+`: 30}},
+	{iri: "_:bob", props: {score: [2.0, 2.5, 3.0], ok: true, tag: ["30", "x"], born: datetime("2000-01-02T03:04:05Z")}}
+] AS row
+MERGE (n:Resource {iri: row.iri}) SET n += row.props;
+UNWIND [
+	{iri: "http://example.org/carol", props: {}}
+] AS row
+MERGE (n:Resource {iri: row.iri}) SET n += row.props;
+UNWIND [
+	["http://example.org/alice", "_:bob"],
+	["http://example.org/carol", "http://example.org/alice"]
+] AS row
+MATCH (s:Resource {iri: row[0]}), (o:Resource {iri: row[1]})
+MERGE (s)-[:`+"`http://example.org/knows`"+`]->(o);
+UNWIND [
+	["_:bob", "http://example.org/carol"]
+] AS row
+MATCH (s:Resource {iri: row[0]}), (o:Resource {iri: row[1]})
+MERGE (s)-[:`+"`http://example.org/likes`"+`]->(o);
+`, buf.String())
+}
+
+func TestEscapeName(t *testing.T) {
+	require.Equal(t, "name_1", cypher.EscapeName("name_1"))
+	require.Equal(t, "`1st`", cypher.EscapeName("1st"))
+	require.Equal(t, "`a``b`", cypher.EscapeName("a`b"))
+	require.Equal(t, "``", cypher.EscapeName(""))
+}
@@ -0,0 +1,298 @@
+// Package cytoscape provides an encoder for Cytoscape.js JSON graph format, also suitable for D3.
+//
+// See https://js.cytoscape.org/#notation/elements-json for the format definition.
+package cytoscape
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/pgraph"
+	"github.com/cayleygraph/quad/voc/rdf"
+)
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "cytoscape",
+		Ext:    []string{".cyjs"},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w) },
+	})
+}
+
+// Reserved data fields. Properties with the same names are written with full IRIs.
+const (
+	fieldID     = "id"
+	fieldLabel  = "label"
+	fieldParent = "parent"
+	fieldType   = "type"
+	fieldSource = "source"
+	fieldTarget = "target"
+)
+
+// element is a node or an edge.
+type element struct {
+	id     string
+	label  string
+	parent string // node only
+	src    string // edge only
+	dst    string // edge only
+	types  []interface{}
+	keys   []string // property names in order of appearance
+	props  map[string][]interface{}
+}
+
+func (e *element) add(key string, v interface{}) {
+	if e.props == nil {
+		e.props = make(map[string][]interface{})
+	}
+	if _, ok := e.props[key]; !ok {
+		e.keys = append(e.keys, key)
+	}
+	e.props[key] = append(e.props[key], v)
+}
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns a Cytoscape.js JSON encoder that writes its output to the provided io.Writer.
+func NewWriter(w io.Writer) *Writer {
+	cw := &Writer{
+		w:     bufio.NewWriter(w),
+		nodes: make(map[string]*element), edges: make(map[string]*element),
+	}
+	cw.enc = json.NewEncoder(&cw.buf)
+	cw.enc.SetEscapeHTML(false)
+	return cw
+}
+
+// Writer implements Cytoscape.js JSON document generator. It writes an object with nodes and edges
+// arrays, where each element holds its fields in a data object.
+//
+// Node IDs are derived from values, so that edges can reference their source and target by data.id.
+// Quads with IRI or blank node objects are written as edges labeled with predicates. Literal properties
+// and rdf:type values are written as data fields of nodes, named by shortened predicate IRIs.
+// Multiple values of the same field are written as arrays. Properties of RDF-star quoted triples
+// are written as data fields of the corresponding edges. Named graphs are written as compound nodes,
+// which are set as parents of nodes from those graphs.
+//
+// Fields of a node can come from any quad, and compound nodes are listed before their children,
+// so elements are collected and written on Close.
+type Writer struct {
+	w   *bufio.Writer
+	buf bytes.Buffer
+	enc *json.Encoder // writes to buf
+	err error
+
+	order []*element // nodes in order of appearance
+	nodes map[string]*element
+	list  []*element // edges in order of appearance
+	edges map[string]*element
+}
+
+// label returns a human-readable label for a value.
+func label(v quad.Value) string {
+	switch v := v.(type) {
+	case quad.IRI:
+		return string(v.Short())
+	case quad.LangString:
+		return string(v.Value)
+	case quad.TypedString:
+		return string(v.Value)
+	}
+	return quad.ToString(v)
+}
+
+// field returns a data field name for a predicate.
+func field(p quad.Value) string {
+	name := label(p)
+	switch name {
+	case fieldID, fieldLabel, fieldParent, fieldType, fieldSource, fieldTarget:
+		return p.String()
+	}
+	return name
+}
+
+// native converts a literal to a JSON value.
+func native(v quad.Value) interface{} {
+	switch v := v.(type) {
+	case quad.Int:
+		return int64(v)
+	case quad.Float:
+		return float64(v)
+	case quad.Bool:
+		return bool(v)
+	case quad.Time:
+		return time.Time(v).UTC().Format(time.RFC3339Nano)
+	}
+	return label(v)
+}
+
+// node returns a node for a value, adding it if necessary. The parent is only set once.
+func (w *Writer) node(v, parent quad.Value) *element {
+	id := pgraph.NodeID(v)
+	n, ok := w.nodes[id]
+	if !ok {
+		n = &element{id: id, label: label(v)}
+		w.nodes[id] = n
+		w.order = append(w.order, n)
+	}
+	if parent != nil && n.parent == "" {
+		n.parent = w.node(parent, nil).id
+	}
+	return n
+}
+
+// edge returns an edge, adding it if necessary. Duplicate edges are merged.
+func (w *Writer) edge(s, p, o, g quad.Value) *element {
+	key := quad.Triple{Subject: s, Predicate: p, Object: o}.String()
+	if e, ok := w.edges[key]; ok {
+		return e
+	}
+	e := &element{
+		id: fmt.Sprintf("e%d", len(w.list)), label: label(p),
+		src: w.node(s, g).id, dst: w.node(o, g).id,
+	}
+	w.edges[key] = e
+	w.list = append(w.list, e)
+	return e
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	}
+	if p, ok := q.Predicate.(quad.IRI); ok && p.Full() == quad.IRI(rdf.Type).Full() {
+		n := w.node(q.Subject, q.Label)
+		n.types = append(n.types, label(q.Object))
+		return nil
+	} else if pgraph.IsNode(q.Object) {
+		w.edge(q.Subject, q.Predicate, q.Object, q.Label)
+		return nil
+	}
+	if t, ok := q.Subject.(quad.Triple); ok && pgraph.IsNode(t.Object) {
+		w.edge(t.Subject, t.Predicate, t.Object, q.Label).add(field(q.Predicate), native(q.Object))
+		return nil
+	}
+	w.node(q.Subject, q.Label).add(field(q.Predicate), native(q.Object))
+	return nil
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+func (w *Writer) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.w.WriteString(s)
+}
+
+// writeField writes a single data field, prefixed with a comma if it's not the first one.
+func (w *Writer) writeField(first bool, key string, v interface{}) {
+	if w.err != nil {
+		return
+	}
+	if !first {
+		w.writeString(",")
+	}
+	w.buf.Reset()
+	if w.err = w.enc.Encode(key); w.err != nil {
+		return
+	}
+	w.buf.Truncate(w.buf.Len() - 1) // trailing newline
+	w.buf.WriteByte(':')
+	if w.err = w.enc.Encode(v); w.err != nil {
+		return
+	}
+	w.buf.Truncate(w.buf.Len() - 1)
+	_, w.err = w.w.Write(w.buf.Bytes())
+}
+
+// fieldValue returns a single value or an array of values.
+func fieldValue(vals []interface{}) interface{} {
+	if len(vals) == 1 {
+		return vals[0]
+	}
+	return vals
+}
+
+func (w *Writer) writeElement(e *element, edge bool) {
+	w.writeString(`{"data":{`)
+	w.writeField(true, fieldID, e.id)
+	if edge {
+		w.writeField(false, fieldSource, e.src)
+		w.writeField(false, fieldTarget, e.dst)
+	}
+	w.writeField(false, fieldLabel, e.label)
+	if e.parent != "" {
+		w.writeField(false, fieldParent, e.parent)
+	}
+	if len(e.types) != 0 {
+		w.writeField(false, fieldType, fieldValue(e.types))
+	}
+	for _, k := range e.keys {
+		w.writeField(false, k, fieldValue(e.props[k]))
+	}
+	w.writeString("}}")
+}
+
+// writeNode writes a node after its parents, unless it's already written. It returns the number of written nodes.
+func (w *Writer) writeNode(n *element, seen map[string]bool, cnt int) int {
+	if seen[n.id] {
+		return cnt
+	}
+	seen[n.id] = true // set before parents to break cycles
+	if p := w.nodes[n.parent]; p != nil {
+		cnt = w.writeNode(p, seen, cnt)
+	}
+	if cnt != 0 {
+		w.writeString(",")
+	}
+	w.writeString("\n")
+	w.writeElement(n, false)
+	return cnt + 1
+}
+
+// Close writes the document and flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.writeString(`{"nodes":[`)
+	seen := make(map[string]bool, len(w.order))
+	cnt := 0
+	for _, n := range w.order {
+		cnt = w.writeNode(n, seen, cnt)
+	}
+	w.writeString("\n],\"edges\":[")
+	for i, e := range w.list {
+		if i != 0 {
+			w.writeString(",")
+		}
+		w.writeString("\n")
+		w.writeElement(e, true)
+	}
+	w.writeString("\n]}\n")
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	if w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	return nil
+}
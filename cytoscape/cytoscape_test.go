@@ -0,0 +1,60 @@
+package cytoscape_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/cytoscape"
+	"github.com/cayleygraph/quad/voc/rdf"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+func TestWriter(t *testing.T) {
+	knows := quad.Triple{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")}
+	quads := []quad.Quad{
+		{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob"), Label: iri("team")},
+		{Subject: iri("alice"), Predicate: quad.IRI(rdf.Type), Object: iri("Person")},
+		{Subject: iri("alice"), Predicate: quad.IRI("rdf:type"), Object: quad.IRI("http://schema.org/Person")},
+		{Subject: iri("alice"), Predicate: iri("name"), Object: quad.LangString{Value: "Alice", Lang: "en"}},
+		{Subject: iri("alice"), Predicate: quad.IRI("http://schema.org/age"), Object: quad.Int(42)},
+		{Subject: quad.BNode("bob"), Predicate: iri("score"), Object: quad.Float(0.5)},
+		{Subject: quad.BNode("bob"), Predicate: iri("score"), Object: quad.Float(1.5)},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("label"), Object: quad.Bool(true)},
+		{Subject: knows, Predicate: iri("since"), Object: quad.Int(2020)},
+		{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")},
+	}
+	buf := bytes.NewBuffer(nil)
+	w := cytoscape.NewWriter(buf)
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, `{"nodes":[
+{"data":{"id":"http://example.org/team","label":"http://example.org/team"}},
+{"data":{"id":"http://example.org/alice","label":"http://example.org/alice","parent":"http://example.org/team","type":["http://example.org/Person","schema:Person"],"http://example.org/name":"Alice","schema:age":42}},
+{"data":{"id":"_:bob","label":"_:bob","parent":"http://example.org/team","http://example.org/score":[0.5,1.5],"<label>":true}}
+],"edges":[
+{"data":{"id":"e0","source":"http://example.org/alice","target":"_:bob","label":"http://example.org/knows","http://example.org/since":2020}}
+]}
+`, buf.String())
+
+	var doc struct {
+		Nodes []struct{ Data map[string]interface{} }
+		Edges []struct{ Data map[string]interface{} }
+	}
+	require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+	require.Len(t, doc.Nodes, 3)
+	require.Len(t, doc.Edges, 1)
+}
+
+func TestWriterEmpty(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	w := cytoscape.NewWriter(buf)
+	require.NoError(t, w.Close())
+	require.Equal(t, "{\"nodes\":[\n],\"edges\":[\n]}\n", buf.String())
+	require.Error(t, w.WriteQuad(quad.Quad{Subject: iri("a"), Predicate: iri("b"), Object: iri("c")}))
+}
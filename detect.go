@@ -0,0 +1,62 @@
+package quad
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"sort"
+)
+
+// SniffLen is the maximal number of bytes passed to Format.Sniff.
+const SniffLen = 4096
+
+// DetectFormat detects a format of the stream by its content.
+//
+// It returns the format and a reader that must be used instead of r, since it contains
+// the bytes consumed during detection. If the stream is compressed by one of registered codecs,
+// the returned reader is decompressed and the format is detected from decompressed data.
+//
+// Format is nil if it cannot be detected. An error is returned only if the stream cannot be read.
+func DetectFormat(r io.Reader) (*Format, io.Reader, error) {
+	br := bufio.NewReaderSize(r, SniffLen)
+	head, err := br.Peek(SniffLen)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return nil, br, err
+	}
+	for _, c := range sortedCodecs() {
+		if len(c.Magic) == 0 || !bytes.HasPrefix(head, c.Magic) {
+			continue
+		}
+		cr, err := c.Reader(br)
+		if err != nil {
+			return nil, br, err
+		}
+		return DetectFormat(cr)
+	}
+	for _, f := range sortedFormats() {
+		if f.Sniff != nil && f.Sniff(head) {
+			return f, br, nil
+		}
+	}
+	return nil, br, nil
+}
+
+// sortedFormats returns registered formats sorted by name, so detection is deterministic.
+func sortedFormats() []*Format {
+	list := make([]*Format, 0, len(formatsByName))
+	for _, f := range formatsByName {
+		list = append(list, f)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// sortedCodecs returns registered codecs sorted by name, so detection is deterministic.
+func sortedCodecs() []*Codec {
+	list := make([]*Codec, 0, len(codecsByName))
+	for _, c := range codecsByName {
+		list = append(list, c)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
@@ -0,0 +1,138 @@
+package quad_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"testing"
+
+	"github.com/cayleygraph/quad"
+	_ "github.com/cayleygraph/quad/graphml"
+	_ "github.com/cayleygraph/quad/hdt"
+	_ "github.com/cayleygraph/quad/hextuples"
+	_ "github.com/cayleygraph/quad/jelly"
+	_ "github.com/cayleygraph/quad/json"
+	_ "github.com/cayleygraph/quad/jsonld"
+	_ "github.com/cayleygraph/quad/nquads"
+	_ "github.com/cayleygraph/quad/pquads"
+	_ "github.com/cayleygraph/quad/rdfjson"
+	_ "github.com/cayleygraph/quad/rdfxml"
+	_ "github.com/cayleygraph/quad/trix"
+	_ "github.com/cayleygraph/quad/turtle"
+)
+
+var detectQuads = []quad.Quad{
+	{Subject: quad.IRI("http://example.org/alice"), Predicate: quad.IRI("http://example.org/knows"), Object: quad.IRI("http://example.org/bob")},
+	{Subject: quad.IRI("http://example.org/bob"), Predicate: quad.IRI("http://example.org/name"), Object: quad.String("Bob")},
+}
+
+// detectGraphQuads are used for formats that can be only distinguished by named graphs.
+var detectGraphQuads = []quad.Quad{
+	detectQuads[0],
+	{Subject: quad.IRI("http://example.org/bob"), Predicate: quad.IRI("http://example.org/name"), Object: quad.String("Bob"), Label: quad.IRI("http://example.org/g")},
+}
+
+func encodeAs(t *testing.T, name string, quads []quad.Quad) []byte {
+	f := quad.FormatByName(name)
+	if f == nil {
+		t.Fatalf("format %q is not registered", name)
+	}
+	buf := bytes.NewBuffer(nil)
+	w := f.Writer(buf)
+	if _, err := quad.Copy(w, quad.NewReader(quads)); err != nil {
+		t.Fatal(name, err)
+	} else if err = w.Close(); err != nil {
+		t.Fatal(name, err)
+	}
+	return buf.Bytes()
+}
+
+func TestDetectFormat(t *testing.T) {
+	for _, name := range []string{
+		"graphml", "hdt", "hextuples", "jelly", "json", "json-stream", "jsonld",
+		"nquads", "pquads", "rdfjson", "rdfxml", "trig", "trix", "turtle",
+	} {
+		t.Run(name, func(t *testing.T) {
+			quads := detectQuads
+			if name == "trig" {
+				quads = detectGraphQuads
+			}
+			data := encodeAs(t, name, quads)
+			for _, f := range quad.Formats() {
+				if f.Sniff != nil && f.Name != name && f.Sniff(data) {
+					t.Errorf("format %q also matches", f.Name)
+				}
+			}
+			f, r, err := quad.DetectFormat(bytes.NewReader(data))
+			if err != nil {
+				t.Fatal(err)
+			} else if f == nil || f.Name != name {
+				t.Fatalf("unexpected format: %v", f)
+			}
+			// the returned reader must contain the whole stream
+			got, err := io.ReadAll(r)
+			if err != nil {
+				t.Fatal(err)
+			} else if !bytes.Equal(got, data) {
+				t.Fatalf("unexpected data: %q", got)
+			}
+		})
+	}
+}
+
+func TestDetectFormatText(t *testing.T) {
+	for _, c := range []struct {
+		data, name string
+	}{
+		{"# comment\n<http://example.org/a> <http://example.org/p> \"{\" .\n", "nquads"},
+		{"PREFIX ex: <http://example.org/>\nex:a ex:p \"\"\"{\n}\"\"\" .\n", "turtle"},
+		{"<http://example.org/a> <http://example.org/p> <http://example.org/b> .\n<http://example.org/g> {\n}\n", "trig"},
+		{"@base <http://example.org/> .\nGRAPH <g> { <a> <p> <b> }\n", "trig"},
+		{"ex:a ex:p ex:b .\n", ""},
+	} {
+		f, _, err := quad.DetectFormat(bytes.NewReader([]byte(c.data)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		var name string
+		if f != nil {
+			name = f.Name
+		}
+		if name != c.name {
+			t.Errorf("unexpected format for %q: %q", c.data, name)
+		}
+	}
+}
+
+func TestDetectFormatCompressed(t *testing.T) {
+	data := encodeAs(t, "nquads", detectQuads)
+	buf := bytes.NewBuffer(nil)
+	zw := gzip.NewWriter(buf)
+	zw.Write(data)
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	f, r, err := quad.DetectFormat(buf)
+	if err != nil {
+		t.Fatal(err)
+	} else if f == nil || f.Name != "nquads" {
+		t.Fatalf("unexpected format: %v", f)
+	}
+	quads, err := quad.ReadAll(f.Reader(r))
+	if err != nil {
+		t.Fatal(err)
+	} else if len(quads) != len(detectQuads) {
+		t.Fatalf("unexpected quads: %v", quads)
+	}
+}
+
+func TestDetectFormatUnknown(t *testing.T) {
+	for _, data := range []string{"", "plain text", "{}", "<html></html>"} {
+		f, _, err := quad.DetectFormat(bytes.NewReader([]byte(data)))
+		if err != nil {
+			t.Fatal(err)
+		} else if f != nil {
+			t.Errorf("unexpected format for %q: %v", data, f.Name)
+		}
+	}
+}
@@ -1,4 +1,6 @@
-// Package dot provides an encoder for DOT format (graphviz).
+// Package dot provides an encoder and a decoder for DOT format (graphviz).
+//
+// See https://graphviz.org/doc/info/lang.html for the language definition.
 package dot
 
 import (
@@ -14,6 +16,7 @@ func init() {
 		Name:   "graphviz",
 		Ext:    []string{".gv", ".dot"},
 		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r, nil) },
 	})
 }
 
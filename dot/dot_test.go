@@ -2,10 +2,15 @@ package dot_test
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/cayleygraph/quad"
 	"github.com/cayleygraph/quad/dot"
+	"github.com/cayleygraph/quad/nquads"
+	"github.com/cayleygraph/quad/voc/rdfs"
 )
 
 var testData = []struct {
@@ -69,3 +74,125 @@ func TestWriter(t *testing.T) {
 		}
 	}
 }
+
+const readerData = `/* architecture */
+strict digraph "arch" {
+	rankdir = LR; // graph attribute
+	node [shape=box]
+	web [label="Web server", color=blue];
+	web -> db:port:n [label=queries weight=2]
+	subgraph cluster_backend {
+		edge [style=dashed]
+		db; cache
+		db -> cache
+	}
+	# preprocessor-like comment
+	"<http://example.org/lb>" -> { web "api" } [label="<http://example.org/routes>"]
+	legend [label=<<b>Legend</b>>]
+	"multi" + "line" -> web
+}
+`
+
+func TestReader(t *testing.T) {
+	r := dot.NewReader(bytes.NewBufferString(readerData), nil)
+	defer r.Close()
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	web, db, cache, api := quad.BNode("web"), quad.BNode("db"), quad.BNode("cache"), quad.BNode("api")
+	lb, routes := quad.IRI("http://example.org/lb"), quad.IRI("http://example.org/routes")
+	backend := quad.BNode("cluster_backend")
+	shape, box := quad.IRI("shape"), quad.String("box")
+	require.Equal(t, []quad.Quad{
+		{Subject: web, Predicate: shape, Object: box},
+		{Subject: web, Predicate: quad.IRI("label"), Object: quad.String("Web server")},
+		{Subject: web, Predicate: quad.IRI("color"), Object: quad.String("blue")},
+		{Subject: db, Predicate: shape, Object: box},
+		{Subject: web, Predicate: quad.IRI("queries"), Object: db},
+		{Subject: cache, Predicate: shape, Object: box, Label: backend},
+		{Subject: db, Predicate: dot.DefaultPredicate, Object: cache, Label: backend},
+		{Subject: lb, Predicate: shape, Object: box},
+		{Subject: api, Predicate: shape, Object: box},
+		{Subject: lb, Predicate: routes, Object: web},
+		{Subject: lb, Predicate: routes, Object: api},
+		{Subject: quad.BNode("legend"), Predicate: shape, Object: box},
+		{Subject: quad.BNode("legend"), Predicate: quad.IRI("label"), Object: quad.String("<b>Legend</b>")},
+		{Subject: quad.BNode("multiline"), Predicate: shape, Object: box},
+		{Subject: quad.BNode("multiline"), Predicate: dot.DefaultPredicate, Object: web},
+	}, got)
+}
+
+func TestReaderEdgeProperties(t *testing.T) {
+	r := dot.NewReader(bytes.NewBufferString(readerData), &dot.ReaderOptions{EdgeProperties: true})
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	web, db, cache := quad.BNode("web"), quad.BNode("db"), quad.BNode("cache")
+	queries := quad.Triple{Subject: web, Predicate: quad.IRI("queries"), Object: db}
+	require.Contains(t, got, quad.Quad{Subject: queries, Predicate: quad.IRI("weight"), Object: quad.String("2")})
+	dashed := quad.Triple{Subject: db, Predicate: dot.DefaultPredicate, Object: cache}
+	require.Contains(t, got, quad.Quad{Subject: dashed, Predicate: quad.IRI("style"), Object: quad.String("dashed"), Label: quad.BNode("cluster_backend")})
+}
+
+func TestReaderLabels(t *testing.T) {
+	const data = `digraph { "web server" -> "db 1" [label="reads from"]; "db 1" -> "cache, v2!"; -1.5 -> web_2 }`
+	got, err := quad.ReadAll(dot.NewReader(bytes.NewBufferString(data), nil))
+	require.NoError(t, err)
+	labels := make(map[string]quad.Value)
+	for _, q := range got {
+		if q.Predicate == quad.IRI(rdfs.Label).Full() {
+			labels[quad.ToString(q.Object)] = q.Subject
+		}
+	}
+	require.Len(t, labels, 3)
+	web, db, cache := labels["web server"], labels["db 1"], labels["cache, v2!"]
+	require.Contains(t, got, quad.Quad{Subject: web, Predicate: quad.IRI("reads%20from"), Object: db})
+	require.Contains(t, got, quad.Quad{Subject: db, Predicate: dot.DefaultPredicate, Object: cache})
+	require.Contains(t, got, quad.Quad{Subject: quad.BNode("-1.5"), Predicate: dot.DefaultPredicate, Object: quad.BNode("web_2")})
+
+	var buf bytes.Buffer
+	w := nquads.NewWriter(&buf)
+	_, err = w.WriteQuads(got)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	back, err := quad.ReadAll(nquads.NewReader(&buf, false))
+	require.NoError(t, err)
+	require.Equal(t, got, back)
+}
+
+func TestReaderUndirected(t *testing.T) {
+	got, err := quad.ReadAll(dot.NewReader(bytes.NewBufferString(`graph { a -- b -- c }`), &dot.ReaderOptions{
+		Predicate: quad.IRI("http://example.org/linked"),
+	}))
+	require.NoError(t, err)
+	p := quad.IRI("http://example.org/linked")
+	require.Equal(t, []quad.Quad{
+		{Subject: quad.BNode("a"), Predicate: p, Object: quad.BNode("b")},
+		{Subject: quad.BNode("b"), Predicate: p, Object: quad.BNode("c")},
+	}, got)
+}
+
+func TestReaderRoundTrip(t *testing.T) {
+	for _, c := range testData {
+		got, err := quad.ReadAll(dot.NewReader(bytes.NewBufferString(c.data), nil))
+		require.NoError(t, err)
+		require.Equal(t, c.quads, got)
+	}
+}
+
+func TestReaderErrors(t *testing.T) {
+	for _, data := range []string{
+		`digraph { a -- b }`,
+		`graph { a -> b }`,
+		`tree { a }`,
+		`digraph { a [label] }`,
+		`digraph { a -> }`,
+		`digraph { node }`,
+		`digraph { "a }`,
+		`digraph { a /* b }`,
+		`digraph { a @ b }`,
+	} {
+		_, err := quad.ReadAll(dot.NewReader(bytes.NewBufferString(data), nil))
+		require.Error(t, err, data)
+	}
+	_, err := quad.ReadAll(dot.NewReader(bytes.NewBufferString(`digraph { a -> b`), nil))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
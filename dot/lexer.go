@@ -0,0 +1,320 @@
+package dot
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokID     tokenKind = iota // alphanumeric ID or a numeral
+	tokQuoted                  // double-quoted string
+	tokHTML                    // HTML string
+	tokPunct                   // punctuation or an edge operator
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokID:
+		return "ID"
+	case tokQuoted:
+		return "quoted string"
+	case tokHTML:
+		return "HTML string"
+	case tokPunct:
+		return "punctuation"
+	}
+	return fmt.Sprintf("token(%d)", int(k))
+}
+
+type token struct {
+	kind tokenKind
+	val  string // unquoted and unescaped value
+	line int
+}
+
+// isID checks if the token can be used as an ID.
+func (t token) isID() bool {
+	return t.kind != tokPunct
+}
+
+// is checks if the token is a given punctuation.
+func (t token) is(p string) bool {
+	return t.kind == tokPunct && t.val == p
+}
+
+// isKeyword checks if the token is a given keyword. Keywords are case-independent.
+func (t token) isKeyword(kw string) bool {
+	return t.kind == tokID && strings.EqualFold(t.val, kw)
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokPunct:
+		return "'" + t.val + "'"
+	case tokQuoted:
+		return fmt.Sprintf("%q", t.val)
+	case tokHTML:
+		return "<" + t.val + ">"
+	}
+	return t.val
+}
+
+// lexer splits DOT document into tokens.
+type lexer struct {
+	r    *bufio.Reader
+	line int
+	peek []token
+}
+
+func newLexer(r io.Reader) *lexer {
+	return &lexer{r: bufio.NewReader(r), line: 1}
+}
+
+func isLetter(c rune) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: "+format, append([]interface{}{l.line}, args...)...)
+}
+
+func (l *lexer) readRune() (rune, error) {
+	c, _, err := l.r.ReadRune()
+	if err == nil && c == '\n' {
+		l.line++
+	}
+	return c, err
+}
+
+func (l *lexer) unreadRune(c rune) {
+	l.r.UnreadRune()
+	if c == '\n' {
+		l.line--
+	}
+}
+
+// unread returns a token back to the lexer.
+func (l *lexer) unread(t token) {
+	l.peek = append(l.peek, t)
+}
+
+// next returns the next token, or io.EOF. Concatenated quoted strings are returned as a single token.
+func (l *lexer) next() (token, error) {
+	t, err := l.token()
+	if err != nil || t.kind != tokQuoted {
+		return t, err
+	}
+	for {
+		plus, err := l.token()
+		if err == io.EOF {
+			return t, nil
+		} else if err != nil {
+			return t, err
+		} else if !plus.is("+") {
+			l.unread(plus)
+			return t, nil
+		}
+		s, err := l.token()
+		if err == io.EOF {
+			return t, l.errorf("%w", io.ErrUnexpectedEOF)
+		} else if err != nil {
+			return t, err
+		} else if s.kind != tokQuoted {
+			return t, fmt.Errorf("line %d: expected quoted string after '+', got %v", s.line, s)
+		}
+		t.val += s.val
+	}
+}
+
+// token returns the next raw token.
+func (l *lexer) token() (token, error) {
+	if n := len(l.peek); n != 0 {
+		t := l.peek[n-1]
+		l.peek = l.peek[:n-1]
+		return t, nil
+	}
+	for {
+		c, err := l.readRune()
+		if err != nil {
+			return token{}, err
+		}
+		switch {
+		case unicode.IsSpace(c):
+			continue
+		case c == '#':
+			if err := l.skipLine(); err != nil {
+				return token{}, err
+			}
+			continue
+		case c == '/':
+			c2, err := l.readRune()
+			if err == nil && c2 == '/' {
+				if err := l.skipLine(); err != nil {
+					return token{}, err
+				}
+				continue
+			} else if err == nil && c2 == '*' {
+				if err := l.skipComment(); err != nil {
+					return token{}, err
+				}
+				continue
+			}
+			return token{}, l.errorf("unexpected character: %q", c)
+		case strings.ContainsRune("{}[]=;,:+", c):
+			return token{kind: tokPunct, val: string(c), line: l.line}, nil
+		case c == '-':
+			c2, err := l.readRune()
+			if err == nil && (c2 == '-' || c2 == '>') {
+				return token{kind: tokPunct, val: string([]rune{c, c2}), line: l.line}, nil
+			} else if err == nil {
+				l.unreadRune(c2)
+			}
+			return l.numeral(c)
+		case c == '.' || isDigit(c):
+			return l.numeral(c)
+		case c == '"':
+			return l.quoted()
+		case c == '<':
+			return l.html()
+		case isLetter(c):
+			var sb strings.Builder
+			sb.WriteRune(c)
+			for {
+				c, err := l.readRune()
+				if err == io.EOF {
+					break
+				} else if err != nil {
+					return token{}, err
+				} else if !isLetter(c) && !isDigit(c) {
+					l.unreadRune(c)
+					break
+				}
+				sb.WriteRune(c)
+			}
+			return token{kind: tokID, val: sb.String(), line: l.line}, nil
+		default:
+			return token{}, l.errorf("unexpected character: %q", c)
+		}
+	}
+}
+
+// skipLine skips a comment till the end of the line.
+func (l *lexer) skipLine() error {
+	if _, err := l.r.ReadString('\n'); err != nil && err != io.EOF {
+		return err
+	}
+	l.line++
+	return nil
+}
+
+// skipComment skips a C-style comment.
+func (l *lexer) skipComment() error {
+	star := false
+	for {
+		c, err := l.readRune()
+		if err == io.EOF {
+			return l.errorf("unterminated comment: %w", io.ErrUnexpectedEOF)
+		} else if err != nil {
+			return err
+		} else if star && c == '/' {
+			return nil
+		}
+		star = c == '*'
+	}
+}
+
+// numeral reads a numeral ID: [-]?(.[0-9]+|[0-9]+(.[0-9]*)?).
+func (l *lexer) numeral(first rune) (token, error) {
+	var sb strings.Builder
+	sb.WriteRune(first)
+	dot, digits := first == '.', isDigit(first)
+	for {
+		c, err := l.readRune()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return token{}, err
+		} else if c == '.' && !dot {
+			dot = true
+		} else if isDigit(c) {
+			digits = true
+		} else {
+			l.unreadRune(c)
+			break
+		}
+		sb.WriteRune(c)
+	}
+	if !digits {
+		return token{}, l.errorf("invalid numeral: %q", sb.String())
+	}
+	return token{kind: tokID, val: sb.String(), line: l.line}, nil
+}
+
+// quoted reads a double-quoted string. Escaped quotes and backslashes are unescaped, escaped newlines
+// are removed, and other escape sequences are preserved as is.
+func (l *lexer) quoted() (token, error) {
+	line := l.line
+	var sb strings.Builder
+	for {
+		c, err := l.readRune()
+		if err == io.EOF {
+			return token{}, l.errorf("unterminated string: %w", io.ErrUnexpectedEOF)
+		} else if err != nil {
+			return token{}, err
+		}
+		switch c {
+		case '"':
+			return token{kind: tokQuoted, val: sb.String(), line: line}, nil
+		case '\\':
+			c2, err := l.readRune()
+			if err == io.EOF {
+				return token{}, l.errorf("unterminated string: %w", io.ErrUnexpectedEOF)
+			} else if err != nil {
+				return token{}, err
+			}
+			switch c2 {
+			case '"', '\\':
+				sb.WriteRune(c2)
+			case '\n':
+			default:
+				sb.WriteRune(c)
+				sb.WriteRune(c2)
+			}
+		default:
+			sb.WriteRune(c)
+		}
+	}
+}
+
+// html reads an HTML string. Angle brackets inside it must be balanced.
+func (l *lexer) html() (token, error) {
+	line := l.line
+	var sb strings.Builder
+	depth := 1
+	for {
+		c, err := l.readRune()
+		if err == io.EOF {
+			return token{}, l.errorf("unterminated HTML string: %w", io.ErrUnexpectedEOF)
+		} else if err != nil {
+			return token{}, err
+		}
+		switch c {
+		case '<':
+			depth++
+		case '>':
+			if depth--; depth == 0 {
+				return token{kind: tokHTML, val: sb.String(), line: line}, nil
+			}
+		}
+		sb.WriteRune(c)
+	}
+}
@@ -0,0 +1,483 @@
+package dot
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/bnode"
+	"github.com/cayleygraph/quad/internal/term"
+	"github.com/cayleygraph/quad/voc/rdfs"
+)
+
+// DefaultPredicate is used for edges without labels.
+const DefaultPredicate = quad.IRI("edge")
+
+// ReaderOptions for the DOT decoder.
+type ReaderOptions struct {
+	// Predicate is used for edges without labels. DefaultPredicate is used if it's not set.
+	Predicate quad.Value
+	// EdgeProperties enables returning edge attributes as properties of RDF-star quoted triples.
+	EdgeProperties bool
+}
+
+type attr struct {
+	key string
+	val token
+}
+
+// scope is a graph or a subgraph.
+type scope struct {
+	parent *scope
+	label  quad.Value
+	node   []attr       // default node attributes
+	edge   []attr       // default edge attributes
+	nodes  []quad.Value // nodes of a subgraph; not collected for the root graph
+}
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+// Reader implements DOT document parsing.
+//
+// Edges are returned as quads with the edge label as a predicate, and named subgraphs are used as labels.
+// Quoted IDs are parsed as values in N-Quads syntax, the same way as Writer encodes them. Other IDs are
+// returned as blank nodes named by IDs. IDs that are not valid blank node labels, such as IDs with spaces,
+// are returned as generated blank nodes with an rdfs:label property holding the ID.
+//
+// Node attributes are returned as literal properties of nodes, and other edge attributes are returned
+// according to ReaderOptions. Attribute names and edge labels that are not N-Quads terms are used as IRIs,
+// with characters that are not allowed in IRIs percent-encoded. Ports and graph attributes are ignored.
+type Reader struct {
+	lex  *lexer
+	opts ReaderOptions
+	err  error
+
+	root     *scope
+	directed bool
+	seen     map[string]struct{}   // node IDs of the current graph
+	ids      map[string]quad.Value // generated blank nodes of the current graph
+	seq      bnode.Generator
+	buf      []quad.Quad
+}
+
+// NewReader returns a DOT decoder that takes its input from the provided io.Reader.
+// Options can be nil.
+func NewReader(r io.Reader, opts *ReaderOptions) *Reader {
+	if opts == nil {
+		opts = &ReaderOptions{}
+	}
+	o := *opts
+	if o.Predicate == nil {
+		o.Predicate = DefaultPredicate
+	}
+	return &Reader{lex: newLexer(r), opts: o}
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	for len(r.buf) == 0 && r.err == nil {
+		r.err = r.next()
+	}
+	if len(r.buf) != 0 {
+		q := r.buf[0]
+		r.buf = r.buf[1:]
+		return q, nil
+	}
+	return quad.Quad{}, r.err
+}
+
+// ReadQuads implements quad.BatchReader.
+func (r *Reader) ReadQuads(buf []quad.Quad) (int, error) {
+	for i := range buf {
+		q, err := r.ReadQuad()
+		if err != nil {
+			return i, err
+		}
+		buf[i] = q
+	}
+	return len(buf), nil
+}
+
+// read returns the next token. EOF is unexpected at this point.
+func (r *Reader) read() (token, error) {
+	t, err := r.lex.next()
+	if err == io.EOF {
+		return t, r.lex.errorf("%w", io.ErrUnexpectedEOF)
+	}
+	return t, err
+}
+
+// expect reads the next token and checks that it's a given punctuation.
+func (r *Reader) expect(p string) error {
+	t, err := r.read()
+	if err != nil {
+		return err
+	} else if !t.is(p) {
+		return fmt.Errorf("line %d: expected '%s', got %v", t.line, p, t)
+	}
+	return nil
+}
+
+// next processes a graph header, a single statement of the graph or the end of the graph.
+func (r *Reader) next() error {
+	if r.root == nil {
+		return r.header()
+	}
+	t, err := r.read()
+	if err != nil {
+		return err
+	} else if t.is("}") {
+		r.root, r.seen, r.ids = nil, nil, nil
+		return nil
+	} else if t.is(";") {
+		return nil
+	}
+	r.lex.unread(t)
+	return r.stmt(r.root)
+}
+
+// header reads a graph header: [strict] (graph|digraph) [ID] '{'.
+func (r *Reader) header() error {
+	t, err := r.lex.next()
+	if err != nil {
+		return err
+	}
+	if t.isKeyword("strict") {
+		if t, err = r.read(); err != nil {
+			return err
+		}
+	}
+	switch {
+	case t.isKeyword("digraph"):
+		r.directed = true
+	case t.isKeyword("graph"):
+		r.directed = false
+	default:
+		return fmt.Errorf("line %d: expected graph or digraph, got %v", t.line, t)
+	}
+	if t, err = r.read(); err != nil {
+		return err
+	} else if t.isID() {
+		// graph name is ignored
+		if t, err = r.read(); err != nil {
+			return err
+		}
+	}
+	if !t.is("{") {
+		return fmt.Errorf("line %d: expected '{', got %v", t.line, t)
+	}
+	r.root = &scope{}
+	r.seen = make(map[string]struct{})
+	r.ids = make(map[string]quad.Value)
+	return nil
+}
+
+// value converts an ID to a value.
+func (r *Reader) value(t token) quad.Value {
+	if t.kind == tokQuoted {
+		if v, err := term.Parse(t.val); err == nil {
+			return v
+		}
+	}
+	if term.IsBlankLabel(t.val) {
+		return quad.BNode(t.val)
+	} else if v, ok := r.ids[t.val]; ok {
+		return v
+	}
+	v := r.seq.Next()
+	r.ids[t.val] = v
+	r.buf = append(r.buf, quad.Quad{Subject: v, Predicate: rdfsLabel, Object: quad.String(t.val)})
+	return v
+}
+
+var rdfsLabel = quad.IRI(rdfs.Label).Full()
+
+// iri converts an attribute name or an edge label to an IRI, escaping characters that are not allowed in IRIs.
+func iri(s string) quad.IRI {
+	var sb strings.Builder
+	for _, c := range s {
+		switch {
+		case c <= ' ', strings.ContainsRune(`<>"{}|^\`+"`", c):
+			for _, b := range []byte(string(c)) {
+				fmt.Fprintf(&sb, "%%%02X", b)
+			}
+		default:
+			sb.WriteRune(c)
+		}
+	}
+	return quad.IRI(sb.String())
+}
+
+// stmt parses a single statement.
+func (r *Reader) stmt(sc *scope) error {
+	t, err := r.read()
+	if err != nil {
+		return err
+	}
+	switch {
+	case t.isKeyword("graph"), t.isKeyword("node"), t.isKeyword("edge"):
+		attrs, err := r.attrs(true)
+		if err != nil {
+			return err
+		}
+		switch {
+		case t.isKeyword("node"):
+			sc.node = merge(sc.node, attrs)
+		case t.isKeyword("edge"):
+			sc.edge = merge(sc.edge, attrs)
+		}
+		return nil
+	case t.isKeyword("subgraph"), t.is("{"):
+		sub, err := r.subgraph(t, sc)
+		if err != nil {
+			return err
+		}
+		return r.edgeStmt(sc, sub.nodes)
+	case !t.isID():
+		return fmt.Errorf("line %d: unexpected %v", t.line, t)
+	}
+	n, err := r.read()
+	if err != nil {
+		return err
+	} else if n.is("=") {
+		// graph attribute
+		if v, err := r.read(); err != nil {
+			return err
+		} else if !v.isID() {
+			return fmt.Errorf("line %d: expected ID, got %v", v.line, v)
+		}
+		return nil
+	}
+	r.lex.unread(n)
+	if err = r.port(); err != nil {
+		return err
+	}
+	if n, err = r.read(); err != nil {
+		return err
+	}
+	r.lex.unread(n)
+	if n.is("->") || n.is("--") {
+		v, err := r.node(sc, t, nil)
+		if err != nil {
+			return err
+		}
+		return r.edgeStmt(sc, []quad.Value{v})
+	}
+	attrs, err := r.attrs(false)
+	if err != nil {
+		return err
+	}
+	_, err = r.node(sc, t, attrs)
+	return err
+}
+
+// port skips an optional node port: ':' ID [':' ID].
+func (r *Reader) port() error {
+	for i := 0; i < 2; i++ {
+		t, err := r.read()
+		if err != nil {
+			return err
+		} else if !t.is(":") {
+			r.lex.unread(t)
+			return nil
+		}
+		if t, err = r.read(); err != nil {
+			return err
+		} else if !t.isID() {
+			return fmt.Errorf("line %d: expected port, got %v", t.line, t)
+		}
+	}
+	return nil
+}
+
+// attrs reads attribute lists: '[' [ID '=' ID [';'|',']]... ']'. If required is not set,
+// attribute lists are optional.
+func (r *Reader) attrs(required bool) ([]attr, error) {
+	var out []attr
+	for {
+		t, err := r.read()
+		if err != nil {
+			return nil, err
+		} else if !t.is("[") {
+			if required {
+				return nil, fmt.Errorf("line %d: expected '[', got %v", t.line, t)
+			}
+			r.lex.unread(t)
+			return out, nil
+		}
+		required = false
+		for {
+			k, err := r.read()
+			if err != nil {
+				return nil, err
+			} else if k.is("]") {
+				break
+			} else if k.is(",") || k.is(";") {
+				continue
+			} else if !k.isID() {
+				return nil, fmt.Errorf("line %d: expected attribute name, got %v", k.line, k)
+			}
+			if err = r.expect("="); err != nil {
+				return nil, err
+			}
+			v, err := r.read()
+			if err != nil {
+				return nil, err
+			} else if !v.isID() {
+				return nil, fmt.Errorf("line %d: expected attribute value, got %v", v.line, v)
+			}
+			out = merge(out, []attr{{key: k.val, val: v}})
+		}
+	}
+}
+
+// merge overrides attributes with the same names and appends new ones.
+func merge(base, attrs []attr) []attr {
+	out := append([]attr{}, base...)
+next:
+	for _, a := range attrs {
+		for i := range out {
+			if out[i].key == a.key {
+				out[i] = a
+				continue next
+			}
+		}
+		out = append(out, a)
+	}
+	return out
+}
+
+// subgraph parses a subgraph, starting from the subgraph keyword or an opening brace.
+func (r *Reader) subgraph(t token, parent *scope) (*scope, error) {
+	sc := &scope{
+		parent: parent, label: parent.label,
+		node: parent.node, edge: parent.edge,
+	}
+	if t.isKeyword("subgraph") {
+		var err error
+		if t, err = r.read(); err != nil {
+			return nil, err
+		} else if t.isID() {
+			sc.label = r.value(t)
+			if t, err = r.read(); err != nil {
+				return nil, err
+			}
+		}
+		if !t.is("{") {
+			return nil, fmt.Errorf("line %d: expected '{', got %v", t.line, t)
+		}
+	}
+	for {
+		t, err := r.read()
+		if err != nil {
+			return nil, err
+		} else if t.is("}") {
+			return sc, nil
+		} else if t.is(";") {
+			continue
+		}
+		r.lex.unread(t)
+		if err = r.stmt(sc); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// node declares a node and emits its properties. Default attributes are only applied
+// when the node is seen for the first time.
+func (r *Reader) node(sc *scope, t token, attrs []attr) (quad.Value, error) {
+	v := r.value(t)
+	for s := sc; s.parent != nil; s = s.parent {
+		s.nodes = append(s.nodes, v)
+	}
+	if _, ok := r.seen[t.val]; !ok {
+		r.seen[t.val] = struct{}{}
+		attrs = merge(sc.node, attrs)
+	}
+	for _, a := range attrs {
+		r.buf = append(r.buf, quad.Quad{Subject: v, Predicate: iri(a.key), Object: quad.String(a.val.val), Label: sc.label})
+	}
+	return v, nil
+}
+
+// edgeStmt parses the rest of an edge statement, if any. The left side of the first edge is already parsed.
+func (r *Reader) edgeStmt(sc *scope, left []quad.Value) error {
+	ends := [][]quad.Value{left}
+	for {
+		t, err := r.read()
+		if err != nil {
+			return err
+		} else if !t.is("->") && !t.is("--") {
+			r.lex.unread(t)
+			break
+		} else if t.is("->") != r.directed {
+			return fmt.Errorf("line %d: unexpected edge operator in a %s: %v", t.line, r.kind(), t)
+		}
+		if t, err = r.read(); err != nil {
+			return err
+		}
+		switch {
+		case t.isKeyword("subgraph"), t.is("{"):
+			sub, err := r.subgraph(t, sc)
+			if err != nil {
+				return err
+			}
+			ends = append(ends, sub.nodes)
+		case t.isID():
+			if err = r.port(); err != nil {
+				return err
+			}
+			v, err := r.node(sc, t, nil)
+			if err != nil {
+				return err
+			}
+			ends = append(ends, []quad.Value{v})
+		default:
+			return fmt.Errorf("line %d: expected node or subgraph, got %v", t.line, t)
+		}
+	}
+	if len(ends) == 1 {
+		return nil // standalone subgraph
+	}
+	attrs, err := r.attrs(false)
+	if err != nil {
+		return err
+	}
+	attrs = merge(sc.edge, attrs)
+	p := r.opts.Predicate
+	var props []attr
+	for _, a := range attrs {
+		if a.key != "label" {
+			if r.opts.EdgeProperties {
+				props = append(props, a)
+			}
+		} else if v, err := term.Parse(a.val.val); err == nil && a.val.kind == tokQuoted {
+			p = v
+		} else {
+			p = iri(a.val.val)
+		}
+	}
+	for i := 1; i < len(ends); i++ {
+		for _, s := range ends[i-1] {
+			for _, o := range ends[i] {
+				r.buf = append(r.buf, quad.Quad{Subject: s, Predicate: p, Object: o, Label: sc.label})
+				t := quad.Triple{Subject: s, Predicate: p, Object: o}
+				for _, a := range props {
+					r.buf = append(r.buf, quad.Quad{Subject: t, Predicate: iri(a.key), Object: quad.String(a.val.val), Label: sc.label})
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func (r *Reader) kind() string {
+	if r.directed {
+		return "digraph"
+	}
+	return "graph"
+}
+
+// Close implements quad.ReadCloser.
+func (r *Reader) Close() error { return nil }
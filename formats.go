@@ -3,6 +3,7 @@ package quad
 import (
 	"fmt"
 	"io"
+	"strings"
 )
 
 // Format is a description for quad-file formats.
@@ -23,6 +24,9 @@ type Format struct {
 	MarshalValue func(v Value) ([]byte, error)
 	// UnmarshalValue decodes a value from specific format.
 	UnmarshalValue func(b []byte) (Value, error)
+	// Sniff reports if the beginning of a stream looks like this format. It's optional and used by DetectFormat.
+	// The head is at most SniffLen bytes long and may end in the middle of a statement.
+	Sniff func(head []byte) bool
 }
 
 var (
@@ -58,9 +62,16 @@ func FormatByName(name string) *Format {
 }
 
 // FormatByExt returns a registered format by its file extension.
+// Compound extensions with a compression suffix, like ".nq.gz", are resolved as well.
 // Will return nil if format is not found.
 func FormatByExt(name string) *Format {
-	return formatsByExt[name]
+	if f := formatsByExt[name]; f != nil {
+		return f
+	}
+	if i := strings.LastIndexByte(name, '.'); i > 0 && CodecByExt(name[i:]) != nil {
+		return formatsByExt[name[:i]]
+	}
+	return nil
 }
 
 // FormatByMime returns a registered format by its MIME type.
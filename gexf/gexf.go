@@ -0,0 +1,450 @@
+// Package gexf provides an encoder for GEXF format (Gephi).
+//
+// See https://gexf.net/ for the format definition.
+package gexf
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/pgraph"
+)
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "gexf",
+		Ext:    []string{".gexf"},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w, nil) },
+	})
+}
+
+// Options for the GEXF encoder.
+type Options struct {
+	// StartPredicate is a predicate of time values that are written as start times of nodes and edges,
+	// instead of attributes. Values must be either quad.Time or numbers. Graphs with time values are dynamic.
+	StartPredicate quad.Value
+	// EndPredicate is a predicate of time values that are written as end times of nodes and edges.
+	// It has no effect if StartPredicate is not set.
+	EndPredicate quad.Value
+}
+
+// attribute is a declared node or edge attribute.
+type attribute struct {
+	id    int
+	title string
+	typ   string
+}
+
+// element is a node or an edge.
+type element struct {
+	id     string
+	label  string
+	src    int // edge only
+	dst    int // edge only
+	values map[int][]quad.Value
+	start  []quad.Value
+	end    []quad.Value
+}
+
+// class is a set of attributes of nodes or edges.
+type class struct {
+	name   string
+	attrs  []*attribute
+	byPred map[string]*attribute
+	multi  map[int]bool // attributes with multiple values for a single element
+}
+
+func newClass(name string) *class {
+	return &class{name: name, byPred: make(map[string]*attribute), multi: make(map[int]bool)}
+}
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns a GEXF encoder that writes its output to the provided io.Writer.
+// Options can be nil.
+func NewWriter(w io.Writer, opts *Options) *Writer {
+	if opts == nil {
+		opts = &Options{}
+	}
+	return &Writer{
+		w: bufio.NewWriter(w), opts: *opts,
+		nodeIDs: make(map[string]int), edgeIDs: make(map[string]int),
+		nodeAttrs: newClass("node"), edgeAttrs: newClass("edge"),
+	}
+}
+
+// Writer implements GEXF document generator.
+//
+// IRIs, blank nodes and objects of quads with IRI or blank node objects are written as nodes,
+// and such quads are written as edges labeled with predicates. Quads with other literal objects
+// are written as typed attribute values of their subjects. Quads with RDF-star quoted triple subjects
+// set attributes of edges that correspond to those triples. GEXF has no named graphs, so quad labels are dropped.
+//
+// Attributes must be declared before the nodes that use them, so the document is written on Close.
+type Writer struct {
+	w    *bufio.Writer
+	opts Options
+	err  error
+
+	nodes   []*element
+	nodeIDs map[string]int
+	edges   []*element
+	edgeIDs map[string]int
+
+	nodeAttrs *class
+	edgeAttrs *class
+}
+
+// label returns a human-readable label for a value.
+func label(v quad.Value) string {
+	switch v := v.(type) {
+	case quad.IRI:
+		return string(v)
+	case quad.LangString:
+		return string(v.Value)
+	case quad.TypedString:
+		return string(v.Value)
+	}
+	return quad.ToString(v)
+}
+
+// node returns an index of a node, adding it if necessary.
+func (w *Writer) node(v quad.Value) int {
+	key := v.String()
+	if i, ok := w.nodeIDs[key]; ok {
+		return i
+	}
+	i := len(w.nodes)
+	w.nodes = append(w.nodes, &element{id: "n" + strconv.Itoa(i), label: label(v)})
+	w.nodeIDs[key] = i
+	return i
+}
+
+// edge returns an index of an edge, adding it if necessary. Duplicate edges are merged.
+func (w *Writer) edge(s, p, o quad.Value) int {
+	key := quad.Triple{Subject: s, Predicate: p, Object: o}.String()
+	if i, ok := w.edgeIDs[key]; ok {
+		return i
+	}
+	i := len(w.edges)
+	w.edges = append(w.edges, &element{
+		id: "e" + strconv.Itoa(i), label: label(p),
+		src: w.node(s), dst: w.node(o),
+	})
+	w.edgeIDs[key] = i
+	return i
+}
+
+// attrType returns a GEXF type of a literal.
+func attrType(v quad.Value) string {
+	switch v.(type) {
+	case quad.Int:
+		return "long"
+	case quad.Float:
+		return "double"
+	case quad.Bool:
+		return "boolean"
+	case quad.Time:
+		return "dateTime"
+	}
+	return "string"
+}
+
+// set adds an attribute value to an element.
+func (c *class) set(e *element, p, o quad.Value) {
+	key := label(p)
+	a, ok := c.byPred[key]
+	if !ok {
+		a = &attribute{id: len(c.attrs), title: key, typ: attrType(o)}
+		c.attrs = append(c.attrs, a)
+		c.byPred[key] = a
+	} else if t := attrType(o); a.typ != t {
+		if (a.typ == "long" || a.typ == "double") && (t == "long" || t == "double") {
+			a.typ = "double"
+		} else {
+			a.typ = "string"
+		}
+	}
+	if e.values == nil {
+		e.values = make(map[int][]quad.Value)
+	}
+	e.values[a.id] = append(e.values[a.id], o)
+	if len(e.values[a.id]) > 1 {
+		c.multi[a.id] = true
+	}
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	}
+	if pgraph.IsNode(q.Object) {
+		w.edge(q.Subject, q.Predicate, q.Object)
+		return nil
+	}
+	var (
+		e *element
+		c *class
+	)
+	if t, ok := q.Subject.(quad.Triple); ok && pgraph.IsNode(t.Object) {
+		e, c = w.edges[w.edge(t.Subject, t.Predicate, t.Object)], w.edgeAttrs
+	} else {
+		e, c = w.nodes[w.node(q.Subject)], w.nodeAttrs
+	}
+	if p := w.opts.StartPredicate; p != nil && q.Predicate == p {
+		e.start = append(e.start, q.Object)
+		return nil
+	} else if p = w.opts.EndPredicate; p != nil && w.opts.StartPredicate != nil && q.Predicate == p {
+		e.end = append(e.end, q.Object)
+		return nil
+	}
+	c.set(e, q.Predicate, q.Object)
+	return nil
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+func (w *Writer) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.w.WriteString(s)
+}
+
+func (w *Writer) writeEscaped(s string) {
+	if w.err != nil {
+		return
+	}
+	w.err = xml.EscapeText(w.w, []byte(s))
+}
+
+// writeAttr writes an XML attribute, prefixed with a space.
+func (w *Writer) writeAttr(name, val string) {
+	w.writeString(" " + name + `="`)
+	w.writeEscaped(val)
+	w.writeString(`"`)
+}
+
+// timeFormat checks time values of all elements and returns the GEXF time format.
+func (w *Writer) timeFormat() (string, error) {
+	format := ""
+	for _, list := range [][]*element{w.nodes, w.edges} {
+		for _, e := range list {
+			for _, vals := range [][]quad.Value{e.start, e.end} {
+				for _, v := range vals {
+					f := ""
+					switch v.(type) {
+					case quad.Time:
+						f = "dateTime"
+					case quad.Int, quad.Float:
+						f = "double"
+					default:
+						return "", fmt.Errorf("invalid time value: %v", v)
+					}
+					if format != "" && format != f {
+						return "", fmt.Errorf("time values of different types: %v", v)
+					}
+					format = f
+				}
+			}
+		}
+	}
+	return format, nil
+}
+
+// formatValue formats an attribute or a time value.
+func formatValue(v quad.Value) string {
+	switch v := v.(type) {
+	case quad.Int:
+		return strconv.FormatInt(int64(v), 10)
+	case quad.Float:
+		return strconv.FormatFloat(float64(v), 'g', -1, 64)
+	case quad.Bool:
+		return strconv.FormatBool(bool(v))
+	case quad.Time:
+		return time.Time(v).UTC().Format(time.RFC3339Nano)
+	}
+	return label(v)
+}
+
+// sortTimes sorts time values in place.
+func sortTimes(vals []quad.Value) {
+	sort.SliceStable(vals, func(i, j int) bool {
+		switch a := vals[i].(type) {
+		case quad.Time:
+			return time.Time(a).Before(time.Time(vals[j].(quad.Time)))
+		}
+		return number(vals[i]) < number(vals[j])
+	})
+}
+
+func number(v quad.Value) float64 {
+	switch v := v.(type) {
+	case quad.Int:
+		return float64(v)
+	case quad.Float:
+		return float64(v)
+	}
+	return 0
+}
+
+// writeTimes writes start and end times of an element. A single interval is written as attributes,
+// and multiple intervals are written as spells, pairing sorted start and end times.
+func (w *Writer) writeTimes(e *element, indent string, spells bool) {
+	if !spells {
+		if len(e.start) == 1 && len(e.end) <= 1 {
+			w.writeAttr("start", formatValue(e.start[0]))
+			if len(e.end) == 1 {
+				w.writeAttr("end", formatValue(e.end[0]))
+			}
+		} else if len(e.start) == 0 && len(e.end) == 1 {
+			w.writeAttr("end", formatValue(e.end[0]))
+		}
+		return
+	}
+	sortTimes(e.start)
+	sortTimes(e.end)
+	w.writeString(indent + "<spells>\n")
+	n := len(e.start)
+	if len(e.end) > n {
+		n = len(e.end)
+	}
+	for i := 0; i < n; i++ {
+		w.writeString(indent + "\t<spell")
+		if i < len(e.start) {
+			w.writeAttr("start", formatValue(e.start[i]))
+		}
+		if i < len(e.end) {
+			w.writeAttr("end", formatValue(e.end[i]))
+		}
+		w.writeString("/>\n")
+	}
+	w.writeString(indent + "</spells>\n")
+}
+
+func hasSpells(e *element) bool {
+	return len(e.start) > 1 || len(e.end) > 1
+}
+
+func (w *Writer) writeClass(c *class) {
+	if len(c.attrs) == 0 {
+		return
+	}
+	w.writeString("\t\t<attributes")
+	w.writeAttr("class", c.name)
+	w.writeString(">\n")
+	for _, a := range c.attrs {
+		typ := a.typ
+		if c.multi[a.id] {
+			typ = "liststring"
+		}
+		w.writeString("\t\t\t<attribute")
+		w.writeAttr("id", strconv.Itoa(a.id))
+		w.writeAttr("title", a.title)
+		w.writeAttr("type", typ)
+		w.writeString("/>\n")
+	}
+	w.writeString("\t\t</attributes>\n")
+}
+
+// writeElement writes a node or an edge with its attribute values and spells.
+func (w *Writer) writeElement(c *class, e *element, tag string) {
+	w.writeString("\t\t\t<" + tag)
+	w.writeAttr("id", e.id)
+	if tag == "edge" {
+		w.writeAttr("source", w.nodes[e.src].id)
+		w.writeAttr("target", w.nodes[e.dst].id)
+	}
+	w.writeAttr("label", e.label)
+	w.writeTimes(e, "", false)
+	if len(e.values) == 0 && !hasSpells(e) {
+		w.writeString("/>\n")
+		return
+	}
+	w.writeString(">\n")
+	if len(e.values) != 0 {
+		w.writeString("\t\t\t\t<attvalues>\n")
+		for _, a := range c.attrs {
+			vals, ok := e.values[a.id]
+			if !ok {
+				continue
+			}
+			strs := make([]string, 0, len(vals))
+			for _, v := range vals {
+				strs = append(strs, formatValue(v))
+			}
+			w.writeString("\t\t\t\t\t<attvalue")
+			w.writeAttr("for", strconv.Itoa(a.id))
+			w.writeAttr("value", strings.Join(strs, "|"))
+			w.writeString("/>\n")
+		}
+		w.writeString("\t\t\t\t</attvalues>\n")
+	}
+	if hasSpells(e) {
+		w.writeTimes(e, "\t\t\t\t", true)
+	}
+	w.writeString("\t\t\t</" + tag + ">\n")
+}
+
+// Close writes the document and flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	format, err := w.timeFormat()
+	if err != nil {
+		w.err = err
+		return err
+	}
+	w.writeString(header)
+	w.writeString("\t<graph")
+	w.writeAttr("defaultedgetype", "directed")
+	if format != "" {
+		w.writeAttr("mode", "dynamic")
+		w.writeAttr("timeformat", format)
+	} else {
+		w.writeAttr("mode", "static")
+	}
+	w.writeString(">\n")
+	w.writeClass(w.nodeAttrs)
+	w.writeClass(w.edgeAttrs)
+	w.writeString("\t\t<nodes>\n")
+	for _, e := range w.nodes {
+		w.writeElement(w.nodeAttrs, e, "node")
+	}
+	w.writeString("\t\t</nodes>\n\t\t<edges>\n")
+	for _, e := range w.edges {
+		w.writeElement(w.edgeAttrs, e, "edge")
+	}
+	w.writeString("\t\t</edges>\n\t</graph>\n</gexf>\n")
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	if w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	return nil
+}
+
+const header = `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">
+`
@@ -0,0 +1,137 @@
+package gexf_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/gexf"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+func write(t *testing.T, opts *gexf.Options, quads []quad.Quad) string {
+	buf := bytes.NewBuffer(nil)
+	w := gexf.NewWriter(buf, opts)
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.String()
+}
+
+func TestWriter(t *testing.T) {
+	got := write(t, nil, []quad.Quad{
+		{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob")},
+		{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String("Alice & co")},
+		{Subject: iri("alice"), Predicate: iri("age"), Object: quad.Int(42)},
+		{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob"), Label: iri("graph")},
+		{Subject: quad.BNode("b1"), Predicate: iri("age"), Object: quad.Float(7.5)},
+		{Subject: quad.BNode("b1"), Predicate: iri("nick"), Object: quad.String("b")},
+		{Subject: quad.BNode("b1"), Predicate: iri("nick"), Object: quad.LangString{Value: "bobby", Lang: "en"}},
+		{Subject: quad.BNode("b1"), Predicate: iri("active"), Object: quad.Bool(true)},
+		{Subject: quad.Triple{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob")}, Predicate: iri("since"), Object: quad.Int(2020)},
+	})
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">
+	<graph defaultedgetype="directed" mode="static">
+		<attributes class="node">
+			<attribute id="0" title="http://example.org/name" type="string"/>
+			<attribute id="1" title="http://example.org/age" type="double"/>
+			<attribute id="2" title="http://example.org/nick" type="liststring"/>
+			<attribute id="3" title="http://example.org/active" type="boolean"/>
+		</attributes>
+		<attributes class="edge">
+			<attribute id="0" title="http://example.org/since" type="long"/>
+		</attributes>
+		<nodes>
+			<node id="n0" label="http://example.org/alice">
+				<attvalues>
+					<attvalue for="0" value="Alice &amp; co"/>
+					<attvalue for="1" value="42"/>
+				</attvalues>
+			</node>
+			<node id="n1" label="http://example.org/bob"/>
+			<node id="n2" label="_:b1">
+				<attvalues>
+					<attvalue for="1" value="7.5"/>
+					<attvalue for="2" value="b|bobby"/>
+					<attvalue for="3" value="true"/>
+				</attvalues>
+			</node>
+		</nodes>
+		<edges>
+			<edge id="e0" source="n0" target="n1" label="http://example.org/knows">
+				<attvalues>
+					<attvalue for="0" value="2020"/>
+				</attvalues>
+			</edge>
+		</edges>
+	</graph>
+</gexf>
+`, got)
+}
+
+func TestWriterDynamic(t *testing.T) {
+	date := func(y int) quad.Time { return quad.Time(time.Date(y, 1, 1, 0, 0, 0, 0, time.UTC)) }
+	knows := quad.Triple{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob")}
+	got := write(t, &gexf.Options{StartPredicate: iri("start"), EndPredicate: iri("end")}, []quad.Quad{
+		{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob")},
+		{Subject: iri("alice"), Predicate: iri("start"), Object: date(2020)},
+		{Subject: iri("bob"), Predicate: iri("start"), Object: date(2022)},
+		{Subject: iri("bob"), Predicate: iri("start"), Object: date(2020)},
+		{Subject: iri("bob"), Predicate: iri("end"), Object: date(2021)},
+		{Subject: knows, Predicate: iri("start"), Object: date(2021)},
+		{Subject: knows, Predicate: iri("end"), Object: date(2023)},
+		{Subject: iri("bob"), Predicate: iri("born"), Object: date(1990)},
+	})
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<gexf xmlns="http://www.gexf.net/1.2draft" version="1.2">
+	<graph defaultedgetype="directed" mode="dynamic" timeformat="dateTime">
+		<attributes class="node">
+			<attribute id="0" title="http://example.org/born" type="dateTime"/>
+		</attributes>
+		<nodes>
+			<node id="n0" label="http://example.org/alice" start="2020-01-01T00:00:00Z"/>
+			<node id="n1" label="http://example.org/bob">
+				<attvalues>
+					<attvalue for="0" value="1990-01-01T00:00:00Z"/>
+				</attvalues>
+				<spells>
+					<spell start="2020-01-01T00:00:00Z" end="2021-01-01T00:00:00Z"/>
+					<spell start="2022-01-01T00:00:00Z"/>
+				</spells>
+			</node>
+		</nodes>
+		<edges>
+			<edge id="e0" source="n0" target="n1" label="http://example.org/knows" start="2021-01-01T00:00:00Z" end="2023-01-01T00:00:00Z"/>
+		</edges>
+	</graph>
+</gexf>
+`, got)
+
+	// time predicates are regular attributes by default
+	got = write(t, nil, []quad.Quad{{Subject: iri("alice"), Predicate: iri("start"), Object: quad.Int(1)}})
+	require.Contains(t, got, `<attvalue for="0" value="1"/>`)
+	require.Contains(t, got, `mode="static"`)
+}
+
+func TestWriterErrors(t *testing.T) {
+	opts := &gexf.Options{StartPredicate: iri("start")}
+	for _, quads := range [][]quad.Quad{
+		{{Subject: iri("alice"), Predicate: iri("start"), Object: quad.String("yesterday")}},
+		{
+			{Subject: iri("alice"), Predicate: iri("start"), Object: quad.Int(1)},
+			{Subject: iri("bob"), Predicate: iri("start"), Object: quad.Time(time.Now())},
+		},
+	} {
+		w := gexf.NewWriter(bytes.NewBuffer(nil), opts)
+		_, err := quad.Copy(w, quad.NewReader(quads))
+		require.NoError(t, err)
+		require.Error(t, w.Close())
+	}
+	w := gexf.NewWriter(bytes.NewBuffer(nil), nil)
+	require.Equal(t, quad.ErrInvalid, w.WriteQuad(quad.Quad{Subject: iri("alice")}))
+}
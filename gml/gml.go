@@ -1,4 +1,8 @@
-// Package gml provides an encoder for Graph Modeling Format
+// Package gml provides an encoder and a decoder for Graph Modeling Format
+//
+// The registered format reads documents with default ReaderOptions: edge keys other than label
+// are dropped, since most encoders don't support RDF-star. Use NewReader with EdgeProperties
+// to return them as properties of quoted triples.
 package gml
 
 import (
@@ -14,6 +18,7 @@ func init() {
 		Name:   "gml",
 		Ext:    []string{".gml"},
 		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r, nil) },
 	})
 }
 
@@ -2,8 +2,11 @@ package gml_test
 
 import (
 	"bytes"
+	"io"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/cayleygraph/quad"
 	"github.com/cayleygraph/quad/gml"
 )
@@ -68,3 +71,99 @@ func TestWriter(t *testing.T) {
 		}
 	}
 }
+
+const readerData = `# comment
+Creator "Cayley"
+Version 2
+graph [
+	directed 1
+	node [ id 1 label "Alice" age 42 score 0.5 graphics [ x 1.5 fill "#ff0000" ] ]
+	edge [ source 1 target 2 label "knows" since 2020 ]
+	node [ id 2 label "<http://example.org/bob>" note "&quot;B&amp;B&quot;" ]
+	edge [ source 2 target 1 ]
+]
+`
+
+func TestReader(t *testing.T) {
+	r := gml.NewReader(bytes.NewBufferString(readerData), nil)
+	defer r.Close()
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	alice, bob := quad.BNode("1"), quad.IRI("http://example.org/bob")
+	require.Equal(t, []quad.Quad{
+		{Subject: alice, Predicate: quad.IRI("label"), Object: quad.String("Alice")},
+		{Subject: alice, Predicate: quad.IRI("age"), Object: quad.Int(42)},
+		{Subject: alice, Predicate: quad.IRI("score"), Object: quad.Float(0.5)},
+		{Subject: alice, Predicate: quad.IRI("graphics.x"), Object: quad.Float(1.5)},
+		{Subject: alice, Predicate: quad.IRI("graphics.fill"), Object: quad.String("#ff0000")},
+		{Subject: bob, Predicate: quad.IRI("note"), Object: quad.String(`"B&B"`)},
+		{Subject: bob, Predicate: gml.DefaultPredicate, Object: alice},
+		{Subject: alice, Predicate: quad.IRI("knows"), Object: bob},
+	}, got)
+}
+
+func TestReaderEdgeProperties(t *testing.T) {
+	r := gml.NewReader(bytes.NewBufferString(readerData), &gml.ReaderOptions{EdgeProperties: true})
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	knows := quad.Triple{Subject: quad.BNode("1"), Predicate: quad.IRI("knows"), Object: quad.IRI("http://example.org/bob")}
+	require.Equal(t, quad.Quad{Subject: knows, Predicate: quad.IRI("since"), Object: quad.Int(2020)}, got[len(got)-1])
+}
+
+func TestFormat(t *testing.T) {
+	f := quad.FormatByName("gml")
+	require.NotNil(t, f)
+	r := f.Reader(bytes.NewBufferString(readerData))
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	for _, q := range got {
+		// edge keys are dropped by default
+		require.NotEqual(t, quad.IRI("since"), q.Predicate)
+	}
+	require.Contains(t, got, quad.Quad{Subject: quad.BNode("1"), Predicate: quad.IRI("knows"), Object: quad.IRI("http://example.org/bob")})
+}
+
+func TestReaderRoundTrip(t *testing.T) {
+	quads := []quad.Quad{
+		{Subject: quad.BNode("subject1"), Predicate: quad.IRI("http://an.example/predicate1"), Object: quad.String("Tomás \"de\" Torquemada & co")},
+		{Subject: quad.IRI("http://example.org/bob#me"), Predicate: quad.IRI("http://schema.org/knows"), Object: quad.BNode("subject1")},
+		{Subject: quad.IRI("http://example.org/bob#me"), Predicate: quad.IRI("http://schema.org/name"), Object: quad.LangString{Value: "Bob", Lang: "en"}},
+		{Subject: quad.IRI("http://example.org/bob#me"), Predicate: quad.IRI("http://schema.org/age"), Object: quad.Int(42)},
+	}
+	buf := bytes.NewBuffer(nil)
+	w := gml.NewWriter(buf)
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	got, err := quad.ReadAll(gml.NewReader(buf, nil))
+	require.NoError(t, err)
+	require.Equal(t, quads, got)
+}
+
+func TestReaderErrors(t *testing.T) {
+	for _, data := range []string{
+		`graph [ node [ label "a" ] ]`,
+		`graph [ node [ id 1 ] node [ id 1 ] ]`,
+		`graph [ edge [ source 1 ] ]`,
+		`graph [ node [ id 1 ] edge [ source 1 target 2 ] ]`,
+		`graph [ node [ id 1 label "a ] ]`,
+		`graph [ node [ id 1 ]`,
+		`graph [ node [ id 1 x ] ]`,
+		`graph [ 1 ]`,
+		`graph [ node [ id 1 ] ] ]`,
+		`graph [ node [ id 1 @ ] ]`,
+	} {
+		_, err := quad.ReadAll(gml.NewReader(bytes.NewBufferString(data), nil))
+		require.Error(t, err, data)
+	}
+	for _, data := range []string{
+		`graph [ node [ id 1 ] edge [ source 1 target 1 w 99999999999999999999 ] ]`,
+		`graph [ edge [ source 1 target 1 w 99999999999999999999 ] node [ id 1 ] ]`,
+	} {
+		_, err := quad.ReadAll(gml.NewReader(bytes.NewBufferString(data), &gml.ReaderOptions{EdgeProperties: true}))
+		require.Error(t, err, data)
+	}
+	_, err := quad.ReadAll(gml.NewReader(bytes.NewBufferString(`graph [ node [ id 1 ]`), nil))
+	require.ErrorIs(t, err, io.ErrUnexpectedEOF)
+}
@@ -0,0 +1,160 @@
+package gml
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+type tokenKind int
+
+const (
+	tokKey tokenKind = iota
+	tokInt
+	tokReal
+	tokString
+	tokOpen
+	tokClose
+)
+
+func (k tokenKind) String() string {
+	switch k {
+	case tokKey:
+		return "key"
+	case tokInt:
+		return "integer"
+	case tokReal:
+		return "real"
+	case tokString:
+		return "string"
+	case tokOpen:
+		return "'['"
+	case tokClose:
+		return "']'"
+	}
+	return fmt.Sprintf("token(%d)", int(k))
+}
+
+type token struct {
+	kind tokenKind
+	val  string // raw value; strings are returned without quotes and are not unescaped
+	line int
+}
+
+// lexer splits GML document into tokens.
+type lexer struct {
+	r    *bufio.Reader
+	line int
+	peek *token
+}
+
+func newLexer(r io.Reader) *lexer {
+	return &lexer{r: bufio.NewReader(r), line: 1}
+}
+
+func isLetter(c rune) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: "+format, append([]interface{}{l.line}, args...)...)
+}
+
+func (l *lexer) readRune() (rune, error) {
+	c, _, err := l.r.ReadRune()
+	if err == nil && c == '\n' {
+		l.line++
+	}
+	return c, err
+}
+
+func (l *lexer) unreadRune(c rune) {
+	l.r.UnreadRune()
+	if c == '\n' {
+		l.line--
+	}
+}
+
+// next returns the next token, or io.EOF.
+func (l *lexer) next() (token, error) {
+	if t := l.peek; t != nil {
+		l.peek = nil
+		return *t, nil
+	}
+	for {
+		c, err := l.readRune()
+		if err != nil {
+			return token{}, err
+		}
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			continue
+		case c == '#':
+			// comment till the end of the line
+			if _, err := l.r.ReadString('\n'); err == io.EOF {
+				return token{}, io.EOF
+			} else if err != nil {
+				return token{}, err
+			}
+			l.line++
+			continue
+		case c == '[':
+			return token{kind: tokOpen, line: l.line}, nil
+		case c == ']':
+			return token{kind: tokClose, line: l.line}, nil
+		case c == '"':
+			line := l.line
+			s, err := l.r.ReadString('"')
+			l.line += strings.Count(s, "\n")
+			if err == io.EOF {
+				return token{}, l.errorf("unterminated string: %w", io.ErrUnexpectedEOF)
+			} else if err != nil {
+				return token{}, err
+			}
+			return token{kind: tokString, val: s[:len(s)-1], line: line}, nil
+		case isLetter(c):
+			return l.word(c, tokKey, func(c rune) bool { return isLetter(c) || isDigit(c) })
+		case isDigit(c) || c == '-' || c == '+' || c == '.':
+			t, err := l.word(c, tokInt, func(c rune) bool {
+				return isDigit(c) || c == '.' || c == 'e' || c == 'E' || c == '-' || c == '+'
+			})
+			if err != nil {
+				return t, err
+			} else if strings.ContainsAny(t.val, ".eE") {
+				t.kind = tokReal
+			}
+			return t, nil
+		default:
+			return token{}, l.errorf("unexpected character: %q", c)
+		}
+	}
+}
+
+// word reads a token that consists of runes accepted by fnc.
+func (l *lexer) word(first rune, kind tokenKind, fnc func(rune) bool) (token, error) {
+	var sb strings.Builder
+	sb.WriteRune(first)
+	for {
+		c, err := l.readRune()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return token{}, err
+		} else if !fnc(c) {
+			l.unreadRune(c)
+			break
+		}
+		sb.WriteRune(c)
+	}
+	return token{kind: kind, val: sb.String(), line: l.line}, nil
+}
+
+// unread returns a token back to the lexer.
+func (l *lexer) unread(t token) {
+	l.peek = &t
+}
@@ -0,0 +1,303 @@
+package gml
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/term"
+)
+
+// DefaultPredicate is used for edges without labels.
+const DefaultPredicate = quad.IRI("edge")
+
+// ReaderOptions for the GML decoder.
+type ReaderOptions struct {
+	// Predicate is used for edges without labels. DefaultPredicate is used if it's not set.
+	Predicate quad.Value
+	// EdgeProperties enables returning edge keys as properties of RDF-star quoted triples.
+	EdgeProperties bool
+}
+
+type pair struct {
+	key string
+	val token
+}
+
+type edge struct {
+	src, dst string
+	pairs    []pair
+}
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+// Reader implements GML document parsing.
+//
+// Node labels are parsed as values in N-Quads syntax, the same way as Writer encodes them.
+// Nodes with labels in other forms are returned as blank nodes named by node ids, with a label property.
+// Edge labels are used as predicates. Other node keys are returned as literal properties of nodes,
+// and other edge keys are returned according to ReaderOptions.
+// Nested lists are flattened, joining keys with a dot.
+type Reader struct {
+	lex  *lexer
+	opts ReaderOptions
+	err  error
+
+	inGraph bool
+	nodes   map[string]quad.Value
+	pending []edge // edges that reference nodes declared later
+	buf     []quad.Quad
+}
+
+// NewReader returns a GML decoder that takes its input from the provided io.Reader.
+// Options can be nil.
+func NewReader(r io.Reader, opts *ReaderOptions) *Reader {
+	if opts == nil {
+		opts = &ReaderOptions{}
+	}
+	o := *opts
+	if o.Predicate == nil {
+		o.Predicate = DefaultPredicate
+	}
+	return &Reader{lex: newLexer(r), opts: o}
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	for len(r.buf) == 0 && r.err == nil {
+		r.err = r.next()
+		if r.err == io.EOF && r.inGraph {
+			r.err = r.lex.errorf("%w", io.ErrUnexpectedEOF)
+		}
+	}
+	if len(r.buf) != 0 {
+		q := r.buf[0]
+		r.buf = r.buf[1:]
+		return q, nil
+	}
+	return quad.Quad{}, r.err
+}
+
+// ReadQuads implements quad.BatchReader.
+func (r *Reader) ReadQuads(buf []quad.Quad) (int, error) {
+	for i := range buf {
+		q, err := r.ReadQuad()
+		if err != nil {
+			return i, err
+		}
+		buf[i] = q
+	}
+	return len(buf), nil
+}
+
+// next processes a single key-value pair of the document or the current graph.
+func (r *Reader) next() error {
+	t, err := r.lex.next()
+	if err != nil {
+		return err
+	}
+	if r.inGraph && t.kind == tokClose {
+		r.inGraph = false
+		return r.flushPending()
+	} else if t.kind != tokKey {
+		return fmt.Errorf("line %d: expected key, got %v", t.line, t.kind)
+	}
+	v, err := r.lex.next()
+	if err == io.EOF {
+		return r.lex.errorf("%w", io.ErrUnexpectedEOF)
+	} else if err != nil {
+		return err
+	} else if v.kind == tokClose {
+		return fmt.Errorf("line %d: expected value, got %v", v.line, v.kind)
+	} else if v.kind != tokOpen {
+		return nil // other keys of a document and a graph are ignored
+	}
+	switch {
+	case !r.inGraph && t.val == "graph":
+		r.inGraph = true
+		r.nodes = make(map[string]quad.Value)
+		return nil
+	case r.inGraph && t.val == "node":
+		pairs, err := r.list("", nil)
+		if err != nil {
+			return err
+		}
+		return r.node(t.line, pairs)
+	case r.inGraph && t.val == "edge":
+		pairs, err := r.list("", nil)
+		if err != nil {
+			return err
+		}
+		return r.edge(t.line, pairs)
+	}
+	_, err = r.list("", nil)
+	return err
+}
+
+// list reads key-value pairs of a list until the closing bracket. Nested lists are flattened.
+func (r *Reader) list(prefix string, out []pair) ([]pair, error) {
+	for {
+		t, err := r.lex.next()
+		if err == io.EOF {
+			return nil, r.lex.errorf("%w", io.ErrUnexpectedEOF)
+		} else if err != nil {
+			return nil, err
+		} else if t.kind == tokClose {
+			return out, nil
+		} else if t.kind != tokKey {
+			return nil, fmt.Errorf("line %d: expected key, got %v", t.line, t.kind)
+		}
+		v, err := r.lex.next()
+		if err == io.EOF {
+			return nil, r.lex.errorf("%w", io.ErrUnexpectedEOF)
+		} else if err != nil {
+			return nil, err
+		}
+		switch v.kind {
+		case tokOpen:
+			if out, err = r.list(prefix+t.val+".", out); err != nil {
+				return nil, err
+			}
+		case tokClose:
+			return nil, fmt.Errorf("line %d: expected value, got %v", v.line, v.kind)
+		default:
+			out = append(out, pair{key: prefix + t.val, val: v})
+		}
+	}
+}
+
+// literal converts a scalar value to a literal.
+func literal(t token) (quad.Value, error) {
+	switch t.kind {
+	case tokInt:
+		v, err := strconv.ParseInt(t.val, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid integer: %q", t.line, t.val)
+		}
+		return quad.Int(v), nil
+	case tokReal:
+		v, err := strconv.ParseFloat(t.val, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid real: %q", t.line, t.val)
+		}
+		return quad.Float(v), nil
+	}
+	return quad.String(html.UnescapeString(t.val)), nil
+}
+
+func (r *Reader) node(line int, pairs []pair) error {
+	var (
+		id    string
+		label *token
+		props []pair
+	)
+	for i, p := range pairs {
+		switch p.key {
+		case "id":
+			id = p.val.val
+		case "label":
+			label = &pairs[i].val
+		default:
+			props = append(props, p)
+		}
+	}
+	if id == "" {
+		return fmt.Errorf("line %d: node without an id", line)
+	} else if _, ok := r.nodes[id]; ok {
+		return fmt.Errorf("line %d: duplicate node id: %q", line, id)
+	}
+	var v quad.Value = quad.BNode(id)
+	if label != nil {
+		if lv, err := term.Parse(html.UnescapeString(label.val)); err == nil && label.kind == tokString {
+			v = lv
+		} else {
+			props = append([]pair{{key: "label", val: *label}}, props...)
+		}
+	}
+	r.nodes[id] = v
+	for _, p := range props {
+		o, err := literal(p.val)
+		if err != nil {
+			return err
+		}
+		r.buf = append(r.buf, quad.Quad{Subject: v, Predicate: quad.IRI(p.key), Object: o})
+	}
+	return nil
+}
+
+func (r *Reader) edge(line int, pairs []pair) error {
+	var e edge
+	for _, p := range pairs {
+		switch p.key {
+		case "source":
+			e.src = p.val.val
+		case "target":
+			e.dst = p.val.val
+		default:
+			e.pairs = append(e.pairs, p)
+		}
+	}
+	if e.src == "" || e.dst == "" {
+		return fmt.Errorf("line %d: edge without a source or a target", line)
+	}
+	if ok, err := r.emitEdge(e); err != nil {
+		return err
+	} else if !ok {
+		r.pending = append(r.pending, e)
+	}
+	return nil
+}
+
+// emitEdge emits an edge with its properties. It returns false if nodes of the edge are not declared yet.
+func (r *Reader) emitEdge(e edge) (bool, error) {
+	s, ok1 := r.nodes[e.src]
+	o, ok2 := r.nodes[e.dst]
+	if !ok1 || !ok2 {
+		return false, nil
+	}
+	p := r.opts.Predicate
+	var props []pair
+	for _, kv := range e.pairs {
+		if kv.key != "label" {
+			props = append(props, kv)
+			continue
+		}
+		label := html.UnescapeString(kv.val.val)
+		if v, err := term.Parse(label); err == nil && kv.val.kind == tokString {
+			p = v
+		} else {
+			p = quad.IRI(label)
+		}
+	}
+	r.buf = append(r.buf, quad.Quad{Subject: s, Predicate: p, Object: o})
+	if !r.opts.EdgeProperties {
+		return true, nil
+	}
+	t := quad.Triple{Subject: s, Predicate: p, Object: o}
+	for _, kv := range props {
+		v, err := literal(kv.val)
+		if err != nil {
+			return false, err
+		}
+		r.buf = append(r.buf, quad.Quad{Subject: t, Predicate: quad.IRI(kv.key), Object: v})
+	}
+	return true, nil
+}
+
+// flushPending emits edges that reference nodes declared after them.
+func (r *Reader) flushPending() error {
+	for _, e := range r.pending {
+		if ok, err := r.emitEdge(e); err != nil {
+			return err
+		} else if !ok {
+			return fmt.Errorf("edge references unknown node: %q -> %q", e.src, e.dst)
+		}
+	}
+	r.pending = nil
+	return nil
+}
+
+// Close implements quad.ReadCloser.
+func (r *Reader) Close() error { return nil }
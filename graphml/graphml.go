@@ -1,7 +1,8 @@
-// Package graphml provides an encoder for GraphML format
+// Package graphml provides an encoder and a decoder for GraphML format
 package graphml
 
 import (
+	"bytes"
 	"encoding/xml"
 	"fmt"
 	"io"
@@ -15,9 +16,24 @@ func init() {
 		Ext:    []string{".graphml"},
 		Mime:   []string{"application/xml"},
 		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r, nil) },
+		Sniff:  sniff,
 	})
 }
 
+// sniff checks the root element of an XML document.
+func sniff(head []byte) bool {
+	dec := xml.NewDecoder(bytes.NewReader(head))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return false
+		} else if el, ok := tok.(xml.StartElement); ok {
+			return el.Name.Local == "graphml"
+		}
+	}
+}
+
 func NewWriter(w io.Writer) *Writer {
 	return &Writer{w: w}
 }
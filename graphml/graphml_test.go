@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/cayleygraph/quad"
 	"github.com/cayleygraph/quad/graphml"
 )
@@ -74,3 +76,128 @@ func TestWriter(t *testing.T) {
 		}
 	}
 }
+
+const readerData = `<?xml version="1.0" encoding="UTF-8"?>
+<graphml xmlns="http://graphml.graphdrawing.org/xmlns">
+	<key id="k0" for="node" attr.name="http://example.org/name" attr.type="string"/>
+	<key id="k1" for="node" attr.name="http://example.org/age" attr.type="int">
+		<default>0</default>
+	</key>
+	<key id="k2" for="edge" attr.name="label" attr.type="string"/>
+	<key id="k3" for="edge" attr.name="http://example.org/weight" attr.type="double"/>
+	<key id="k4" for="node" yfiles.type="nodegraphics"/>
+	<graph id="G" edgedefault="directed">
+		<node id="alice">
+			<data key="k0">Alice</data>
+			<data key="k1">42</data>
+			<data key="k4"><shape/></data>
+		</node>
+		<node id="bob"/>
+		<edge source="alice" target="bob">
+			<data key="k2">http://example.org/knows</data>
+			<data key="k3">0.5</data>
+		</edge>
+		<edge source="bob" target="alice"/>
+		<node id="team">
+			<data key="k0">Team</data>
+			<graph id="team:" edgedefault="directed">
+				<node id="carol"><data key="k1">7</data></node>
+				<edge source="carol" target="alice"><data key="k2">&lt;http://example.org/leads&gt;</data></edge>
+			</graph>
+		</node>
+	</graph>
+</graphml>
+`
+
+func TestReader(t *testing.T) {
+	r := graphml.NewReader(bytes.NewBufferString(readerData), nil)
+	defer r.Close()
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	name, age := quad.IRI("http://example.org/name"), quad.IRI("http://example.org/age")
+	require.Equal(t, []quad.Quad{
+		{Subject: quad.BNode("alice"), Predicate: name, Object: quad.String("Alice")},
+		{Subject: quad.BNode("alice"), Predicate: age, Object: quad.Int(42)},
+		{Subject: quad.BNode("bob"), Predicate: age, Object: quad.Int(0)},
+		{Subject: quad.BNode("alice"), Predicate: quad.IRI("http://example.org/knows"), Object: quad.BNode("bob")},
+		{Subject: quad.BNode("bob"), Predicate: graphml.DefaultPredicate, Object: quad.BNode("alice")},
+		{Subject: quad.BNode("team"), Predicate: name, Object: quad.String("Team")},
+		{Subject: quad.BNode("team"), Predicate: age, Object: quad.Int(0)},
+		{Subject: quad.BNode("carol"), Predicate: age, Object: quad.Int(7), Label: quad.BNode("team")},
+		{Subject: quad.BNode("carol"), Predicate: quad.IRI("http://example.org/leads"), Object: quad.BNode("alice"), Label: quad.BNode("team")},
+	}, got)
+}
+
+func TestReaderEdgeProperties(t *testing.T) {
+	pred := quad.IRI("http://example.org/linked")
+	r := graphml.NewReader(bytes.NewBufferString(readerData), &graphml.ReaderOptions{
+		Predicate: pred, EdgeProperties: true,
+	})
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	knows := quad.Triple{Subject: quad.BNode("alice"), Predicate: quad.IRI("http://example.org/knows"), Object: quad.BNode("bob")}
+	require.Contains(t, got, quad.Quad{Subject: knows, Predicate: quad.IRI("http://example.org/weight"), Object: quad.Float(0.5)})
+	require.Contains(t, got, quad.Quad{Subject: quad.BNode("bob"), Predicate: pred, Object: quad.BNode("alice")})
+}
+
+func TestReaderRoundTrip(t *testing.T) {
+	quads := []quad.Quad{
+		{Subject: quad.BNode("subject1"), Predicate: quad.IRI("http://an.example/predicate1"), Object: quad.String("Tomás \"de\" Torquemada")},
+		{Subject: quad.IRI("http://example.org/bob#me"), Predicate: quad.IRI("http://schema.org/knows"), Object: quad.BNode("subject1")},
+		{Subject: quad.IRI("http://example.org/bob#me"), Predicate: quad.IRI("http://schema.org/age"), Object: quad.Int(42)},
+	}
+	buf := bytes.NewBuffer(nil)
+	w := graphml.NewWriter(buf)
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r := graphml.NewReader(buf, nil)
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, quads, got)
+}
+
+func TestReaderForwardEdges(t *testing.T) {
+	const data = `<graphml>
+	<key id="v" for="node" attr.name="value" attr.type="string"/>
+	<key id="n" for="node" attr.name="name" attr.type="string"/>
+	<key id="l" for="edge" attr.name="label" attr.type="string"/>
+	<graph>
+		<edge source="a" target="b"><data key="l">knows</data></edge>
+		<node id="a"><data key="v">&lt;http://example.org/alice&gt;</data><data key="n">Alice</data></node>
+		<node id="b"><data key="v">&lt;http://example.org/bob&gt;</data></node>
+	</graph>
+</graphml>`
+	r := graphml.NewReader(bytes.NewBufferString(data), &graphml.ReaderOptions{ValueKey: "value"})
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	alice, bob := quad.IRI("http://example.org/alice"), quad.IRI("http://example.org/bob")
+	require.Equal(t, []quad.Quad{
+		{Subject: alice, Predicate: graphml.DefaultBase + "name", Object: quad.String("Alice")},
+		{Subject: alice, Predicate: graphml.DefaultBase + "knows", Object: bob},
+	}, got)
+
+	base := quad.IRI("http://example.org/")
+	r = graphml.NewReader(bytes.NewBufferString(data), &graphml.ReaderOptions{ValueKey: "value", Base: base})
+	got, err = quad.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, []quad.Quad{
+		{Subject: alice, Predicate: base + "name", Object: quad.String("Alice")},
+		{Subject: alice, Predicate: base + "knows", Object: bob},
+	}, got)
+}
+
+func TestReaderErrors(t *testing.T) {
+	for _, data := range []string{
+		`<graphml><graph><node/></graph></graphml>`,
+		`<graphml><graph><edge source="a"/></graph></graphml>`,
+		`<graphml><graph><node id="a"><data key="x">1</data></node></graph></graphml>`,
+		`<graphml><key id="k" for="node" attr.name="n" attr.type="int"/><graph><node id="a"><data key="k">x</data></node></graph></graphml>`,
+		`<graphml><graph><node id="a">`,
+		`<graphml><graph><node id="a"/><edge source="a" target="b"/></graph></graphml>`,
+	} {
+		_, err := quad.ReadAll(graphml.NewReader(bytes.NewBufferString(data), nil))
+		require.Error(t, err, data)
+	}
+}
@@ -0,0 +1,461 @@
+package graphml
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/term"
+)
+
+// DefaultBase is used to resolve key names and edge labels that are not IRIs.
+const DefaultBase = quad.IRI("http://graphml.graphdrawing.org/xmlns#")
+
+// DefaultPredicate is used for edges without labels.
+const DefaultPredicate = DefaultBase + "edge"
+
+// writerKey is a name of the keys that hold node values and edge labels in documents produced by Writer.
+const writerKey = "description"
+
+// ReaderOptions for the GraphML decoder.
+//
+// Documents produced by Writer are detected by their keys, unless LabelKey or ValueKey is set.
+type ReaderOptions struct {
+	// Predicate is used for edges without labels. DefaultPredicate is used if it's not set.
+	Predicate quad.Value
+	// LabelKey is a name of the edge data key that holds edge labels. Labels are used as predicates.
+	// Default is "label".
+	LabelKey string
+	// ValueKey is a name of the node data key that holds node values in N-Quads syntax.
+	// If it's not set, nodes are returned as blank nodes named by node ids.
+	ValueKey string
+	// EdgeProperties enables returning edge data as properties of RDF-star quoted triples.
+	EdgeProperties bool
+	// Base is used to resolve key names and edge labels that are not IRIs. DefaultBase is used if it's not set.
+	Base quad.IRI
+}
+
+type key struct {
+	id   string
+	dom  string // "node", "edge", "graph" or "all"
+	name string
+	typ  string
+	def  *string
+}
+
+type keyElem struct {
+	ID      string  `xml:"id,attr"`
+	For     string  `xml:"for,attr"`
+	Name    string  `xml:"attr.name,attr"`
+	Type    string  `xml:"attr.type,attr"`
+	Default *string `xml:"default"`
+}
+
+type dataElem struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
+
+// frame is an open graph, node or edge element.
+type frame struct {
+	kind  string
+	label quad.Value // graph only
+	id    string     // node only
+	v     quad.Value // node only, set when node is flushed
+	src   string     // edge only
+	dst   string     // edge only
+	data  map[string]string
+}
+
+// pendingEdge is an edge with a graph label.
+type pendingEdge struct {
+	f     *frame
+	label quad.Value
+}
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+// Reader implements GraphML document parsing.
+//
+// Edges are returned as quads with the edge label as a predicate. Node data are returned as literal
+// properties of nodes, typed according to attr.type of the key. Nodes with nested graphs are used as
+// labels of quads from those graphs. Edges that reference nodes declared later are returned at the end
+// of the top-level graph.
+type Reader struct {
+	dec  *xml.Decoder
+	opts ReaderOptions
+	auto bool // detect documents produced by Writer
+	err  error
+
+	keys    map[string]*key
+	order   []*key                // keys in declaration order
+	values  map[string]quad.Value // values of declared nodes
+	stack   []*frame
+	pending []pendingEdge // edges that reference nodes declared later
+	buf     []quad.Quad
+}
+
+// NewReader returns a GraphML decoder that takes its input from the provided io.Reader.
+// Options can be nil.
+func NewReader(r io.Reader, opts *ReaderOptions) *Reader {
+	if opts == nil {
+		opts = &ReaderOptions{}
+	}
+	o := *opts
+	auto := o.LabelKey == "" && o.ValueKey == ""
+	if o.Predicate == nil {
+		o.Predicate = DefaultPredicate
+	}
+	if o.LabelKey == "" {
+		o.LabelKey = "label"
+	}
+	if o.Base == "" {
+		o.Base = DefaultBase
+	}
+	return &Reader{
+		dec: xml.NewDecoder(r), opts: o, auto: auto,
+		keys:   make(map[string]*key),
+		values: make(map[string]quad.Value),
+	}
+}
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	line, _ := r.dec.InputPos()
+	return fmt.Errorf("line %d: "+format, append([]interface{}{line}, args...)...)
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	for len(r.buf) == 0 && r.err == nil {
+		r.err = r.next()
+		if r.err == io.EOF && len(r.stack) != 0 {
+			r.err = r.errorf("%w", io.ErrUnexpectedEOF)
+		}
+	}
+	if len(r.buf) != 0 {
+		q := r.buf[0]
+		r.buf = r.buf[1:]
+		return q, nil
+	}
+	return quad.Quad{}, r.err
+}
+
+// ReadQuads implements quad.BatchReader.
+func (r *Reader) ReadQuads(buf []quad.Quad) (int, error) {
+	for i := range buf {
+		q, err := r.ReadQuad()
+		if err != nil {
+			return i, err
+		}
+		buf[i] = q
+	}
+	return len(buf), nil
+}
+
+// top returns the innermost open element.
+func (r *Reader) top() *frame {
+	if len(r.stack) == 0 {
+		return nil
+	}
+	return r.stack[len(r.stack)-1]
+}
+
+// label returns the label of the innermost graph.
+func (r *Reader) label() quad.Value {
+	for i := len(r.stack) - 1; i >= 0; i-- {
+		if f := r.stack[i]; f.kind == "graph" {
+			return f.label
+		}
+	}
+	return nil
+}
+
+func attr(el xml.StartElement, name string) string {
+	for _, a := range el.Attr {
+		if a.Name.Local == name {
+			return a.Value
+		}
+	}
+	return ""
+}
+
+// next processes a single XML token.
+func (r *Reader) next() error {
+	tok, err := r.dec.Token()
+	if err != nil {
+		return err
+	}
+	switch tok := tok.(type) {
+	case xml.StartElement:
+		return r.start(tok)
+	case xml.EndElement:
+		switch tok.Name.Local {
+		case "graph", "node", "edge":
+		default:
+			return nil
+		}
+		f := r.top()
+		if f == nil || f.kind != tok.Name.Local {
+			return r.errorf("unexpected end of element: %s", tok.Name.Local)
+		}
+		switch f.kind {
+		case "node":
+			if err := r.flushNode(f); err != nil {
+				return err
+			}
+		case "edge":
+			if err := r.addEdge(f); err != nil {
+				return err
+			}
+		}
+		r.stack = r.stack[:len(r.stack)-1]
+		if len(r.stack) == 0 {
+			return r.flushPending()
+		}
+	}
+	return nil
+}
+
+func (r *Reader) start(el xml.StartElement) error {
+	switch el.Name.Local {
+	case "key":
+		var k keyElem
+		if err := r.dec.DecodeElement(&k, &el); err != nil {
+			return err
+		}
+		if _, ok := r.keys[k.ID]; ok {
+			return r.errorf("duplicate key: %q", k.ID)
+		}
+		kv := &key{id: k.ID, dom: k.For, name: k.Name, typ: k.Type, def: k.Default}
+		if kv.dom == "" {
+			kv.dom = "all"
+		}
+		r.keys[k.ID] = kv
+		r.order = append(r.order, kv)
+	case "graph":
+		if r.auto {
+			r.auto = false
+			r.detect()
+		}
+		f := &frame{kind: "graph", label: r.label()}
+		if p := r.top(); p != nil && p.kind == "node" {
+			// nested graph is labeled by the node that contains it
+			if err := r.flushNode(p); err != nil {
+				return err
+			}
+			f.label = p.v
+		}
+		r.stack = append(r.stack, f)
+	case "node":
+		id := attr(el, "id")
+		if id == "" {
+			return r.errorf("node without an id")
+		}
+		r.stack = append(r.stack, &frame{kind: "node", id: id, data: make(map[string]string)})
+	case "edge":
+		f := &frame{kind: "edge", src: attr(el, "source"), dst: attr(el, "target"), data: make(map[string]string)}
+		if f.src == "" || f.dst == "" {
+			return r.errorf("edge without a source or a target")
+		}
+		r.stack = append(r.stack, f)
+	case "data":
+		var d dataElem
+		if err := r.dec.DecodeElement(&d, &el); err != nil {
+			return err
+		}
+		f := r.top()
+		if f == nil || f.kind == "graph" {
+			return nil // graph data are not supported
+		} else if _, ok := r.keys[d.Key]; !ok {
+			return r.errorf("undeclared key: %q", d.Key)
+		}
+		if f.kind == "node" && f.v != nil {
+			// data after a nested graph
+			k := r.keys[d.Key]
+			if k.name == "" || k.name == r.opts.ValueKey {
+				return nil
+			}
+			o, err := r.literal(k, d.Value)
+			if err != nil {
+				return err
+			}
+			r.buf = append(r.buf, quad.Quad{Subject: f.v, Predicate: r.iri(k.name), Object: o, Label: r.label()})
+			return nil
+		}
+		f.data[d.Key] = d.Value
+	}
+	return nil
+}
+
+// detect sets the keys used by Writer, if the document declares exactly the keys written by Writer.
+func (r *Reader) detect() {
+	if len(r.order) != 2 {
+		return
+	}
+	n, e := r.order[0], r.order[1]
+	if n.dom == "edge" {
+		n, e = e, n
+	}
+	if n.dom == "node" && n.name == writerKey && e.dom == "edge" && e.name == writerKey {
+		r.opts.LabelKey, r.opts.ValueKey = writerKey, writerKey
+	}
+}
+
+// iri resolves a key name or an edge label against the base IRI, unless it's already an IRI.
+func (r *Reader) iri(s string) quad.IRI {
+	if strings.Contains(s, ":") {
+		return quad.IRI(s)
+	}
+	return r.opts.Base + quad.IRI(s)
+}
+
+// literal converts data value according to the key type.
+func (r *Reader) literal(k *key, s string) (quad.Value, error) {
+	switch k.typ {
+	case "boolean":
+		v, err := strconv.ParseBool(strings.TrimSpace(s))
+		if err != nil {
+			return nil, r.errorf("invalid boolean value for key %q: %q", k.name, s)
+		}
+		return quad.Bool(v), nil
+	case "int", "long":
+		v, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return nil, r.errorf("invalid integer value for key %q: %q", k.name, s)
+		}
+		return quad.Int(v), nil
+	case "float", "double":
+		v, err := strconv.ParseFloat(strings.TrimSpace(s), 64)
+		if err != nil {
+			return nil, r.errorf("invalid float value for key %q: %q", k.name, s)
+		}
+		return quad.Float(v), nil
+	}
+	return quad.String(s), nil
+}
+
+// properties returns literal properties of an element. Keys without a name are skipped.
+func (r *Reader) properties(f *frame, skip string) ([][2]quad.Value, error) {
+	var out [][2]quad.Value
+	for _, k := range r.order {
+		if k.name == "" || k.name == skip || (k.dom != f.kind && k.dom != "all") {
+			continue
+		}
+		s, ok := f.data[k.id]
+		if !ok {
+			if k.def == nil {
+				continue
+			}
+			s = *k.def
+		}
+		o, err := r.literal(k, s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, [2]quad.Value{r.iri(k.name), o})
+	}
+	return out, nil
+}
+
+// dataByName returns data of an element by the key name.
+func (r *Reader) dataByName(f *frame, name string) (string, bool) {
+	for id, s := range f.data {
+		if k := r.keys[id]; k.name == name && (k.dom == f.kind || k.dom == "all") {
+			return s, true
+		}
+	}
+	return "", false
+}
+
+// flushNode emits properties of a node. It's called when the node ends or a nested graph starts.
+func (r *Reader) flushNode(f *frame) error {
+	if f.v != nil {
+		return nil
+	}
+	f.v = quad.BNode(f.id)
+	if name := r.opts.ValueKey; name != "" {
+		if s, ok := r.dataByName(f, name); ok {
+			if v, err := term.Parse(s); err == nil {
+				f.v = v
+			} else {
+				f.v = quad.String(s)
+			}
+		}
+	}
+	r.values[f.id] = f.v
+	props, err := r.properties(f, r.opts.ValueKey)
+	if err != nil {
+		return err
+	}
+	label := r.label()
+	for _, p := range props {
+		r.buf = append(r.buf, quad.Quad{Subject: f.v, Predicate: p[0], Object: p[1], Label: label})
+	}
+	return nil
+}
+
+// addEdge emits an edge, or keeps it until both of its nodes are declared.
+func (r *Reader) addEdge(f *frame) error {
+	_, src := r.values[f.src]
+	_, dst := r.values[f.dst]
+	if !src || !dst {
+		r.pending = append(r.pending, pendingEdge{f: f, label: r.label()})
+		return nil
+	}
+	return r.flushEdge(f, r.label())
+}
+
+// flushPending emits edges that reference nodes declared after them.
+func (r *Reader) flushPending() error {
+	for _, e := range r.pending {
+		_, src := r.values[e.f.src]
+		_, dst := r.values[e.f.dst]
+		if !src || !dst {
+			return r.errorf("edge references unknown node: %q -> %q", e.f.src, e.f.dst)
+		}
+		if err := r.flushEdge(e.f, e.label); err != nil {
+			return err
+		}
+	}
+	r.pending = nil
+	return nil
+}
+
+// flushEdge emits an edge and its properties.
+func (r *Reader) flushEdge(f *frame, label quad.Value) error {
+	q := quad.Quad{
+		Subject:   r.values[f.src],
+		Predicate: r.opts.Predicate,
+		Object:    r.values[f.dst],
+		Label:     label,
+	}
+	if s, ok := r.dataByName(f, r.opts.LabelKey); ok && strings.TrimSpace(s) != "" {
+		s = strings.TrimSpace(s)
+		v, _ := term.Parse(s)
+		switch v.(type) {
+		case quad.IRI, quad.BNode:
+			q.Predicate = v
+		default:
+			q.Predicate = r.iri(s)
+		}
+	}
+	r.buf = append(r.buf, q)
+	if !r.opts.EdgeProperties {
+		return nil
+	}
+	props, err := r.properties(f, r.opts.LabelKey)
+	if err != nil {
+		return err
+	}
+	t := quad.Triple{Subject: q.Subject, Predicate: q.Predicate, Object: q.Object}
+	for _, p := range props {
+		r.buf = append(r.buf, quad.Quad{Subject: t, Predicate: p[0], Object: p[1], Label: q.Label})
+	}
+	return nil
+}
+
+// Close implements quad.ReadCloser.
+func (r *Reader) Close() error { return nil }
@@ -0,0 +1,228 @@
+// Package graphson provides an encoder and a decoder for TinkerPop GraphSON 3.0 format.
+//
+// Graphs are stored as adjacency lists: one vertex per line, with its properties and edges,
+// as written by GraphSONWriter.writeGraph and read by GraphSONReader.readGraph.
+// See https://tinkerpop.apache.org/docs/current/dev/io/#graphson-3d0 for the format definition.
+package graphson
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/voc/rdf"
+)
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "graphson",
+		Mime:   []string{"application/vnd.gremlin-v3.0+json"},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w, nil) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r, nil) },
+	})
+}
+
+// DefaultLabel is a TinkerPop label of vertices without a type.
+const DefaultLabel = "vertex"
+
+// DefaultBase is used to resolve labels and property keys that are not IRIs, if ReaderOptions.Base is not set.
+const DefaultBase = quad.IRI("http://tinkerpop.apache.org/gremlin#")
+
+// EdgeProperties is a convention for representing edge properties in RDF.
+type EdgeProperties int
+
+const (
+	// Star represents edge properties as properties of RDF-star quoted triples:
+	//
+	//	<< s p o >> key value .
+	Star = EdgeProperties(iota)
+	// Reification represents edge properties as properties of rdf:Statement nodes:
+	//
+	//	_:e rdf:type rdf:Statement .
+	//	_:e rdf:subject s .
+	//	_:e rdf:predicate p .
+	//	_:e rdf:object o .
+	//	_:e key value .
+	Reification
+	// None ignores edge properties.
+	None
+)
+
+// Names of GraphSON types.
+const (
+	typeInt32     = "g:Int32"
+	typeInt64     = "g:Int64"
+	typeFloat     = "g:Float"
+	typeDouble    = "g:Double"
+	typeDate      = "g:Date"
+	typeTimestamp = "g:Timestamp"
+	typeList      = "g:List"
+	typeSet       = "g:Set"
+	typeProperty  = "g:Property"
+	typeVProperty = "g:VertexProperty"
+)
+
+var (
+	iriType      = quad.IRI(rdf.Type).Full()
+	iriStatement = quad.IRI(rdf.Statement).Full()
+	iriSubject   = quad.IRI(rdf.Subject).Full()
+	iriPredicate = quad.IRI(rdf.Predicate).Full()
+	iriObject    = quad.IRI(rdf.Object).Full()
+)
+
+// typed is a value with a GraphSON type.
+type typed struct {
+	Type  string      `json:"@type"`
+	Value interface{} `json:"@value"`
+}
+
+// vertexJSON is a line of an adjacency list.
+type vertexJSON struct {
+	ID         interface{}               `json:"id"`
+	Label      string                    `json:"label"`
+	OutE       map[string][]edgeJSON     `json:"outE,omitempty"`
+	InE        map[string][]edgeJSON     `json:"inE,omitempty"`
+	Properties map[string][]propertyJSON `json:"properties,omitempty"`
+}
+
+type edgeJSON struct {
+	ID         interface{}            `json:"id"`
+	InV        interface{}            `json:"inV,omitempty"`
+	OutV       interface{}            `json:"outV,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type propertyJSON struct {
+	ID    interface{} `json:"id"`
+	Value interface{} `json:"value"`
+}
+
+// encodeValue converts a literal to a GraphSON value. Language tags and unknown datatypes are dropped.
+func encodeValue(v quad.Value) interface{} {
+	switch v := v.(type) {
+	case quad.Int:
+		return typed{Type: typeInt64, Value: int64(v)}
+	case quad.Float:
+		f := float64(v)
+		switch {
+		case math.IsNaN(f):
+			return typed{Type: typeDouble, Value: "NaN"}
+		case math.IsInf(f, 1):
+			return typed{Type: typeDouble, Value: "Infinity"}
+		case math.IsInf(f, -1):
+			return typed{Type: typeDouble, Value: "-Infinity"}
+		}
+		return typed{Type: typeDouble, Value: f}
+	case quad.Bool:
+		return bool(v)
+	case quad.Time:
+		return typed{Type: typeDate, Value: time.Time(v).UnixMilli()}
+	case quad.LangString:
+		return string(v.Value)
+	case quad.TypedString:
+		return string(v.Value)
+	}
+	return quad.ToString(v)
+}
+
+// decodeValue converts a GraphSON value to literals. Lists and sets are returned as multiple values.
+func decodeValue(v interface{}) ([]quad.Value, error) {
+	switch v := v.(type) {
+	case nil:
+		return nil, nil
+	case string:
+		return []quad.Value{quad.String(v)}, nil
+	case bool:
+		return []quad.Value{quad.Bool(v)}, nil
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return []quad.Value{quad.Int(i)}, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return nil, err
+		}
+		return []quad.Value{quad.Float(f)}, nil
+	case []interface{}:
+		var out []quad.Value
+		for _, e := range v {
+			vals, err := decodeValue(e)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, vals...)
+		}
+		return out, nil
+	case map[string]interface{}:
+		typ, _ := v["@type"].(string)
+		if typ == "" {
+			return nil, fmt.Errorf("unexpected object without a type")
+		}
+		return decodeTyped(typ, v["@value"])
+	}
+	return nil, fmt.Errorf("unexpected value: %v", v)
+}
+
+// decodeTyped converts a GraphSON value with a type to literals.
+func decodeTyped(typ string, v interface{}) ([]quad.Value, error) {
+	switch typ {
+	case typeInt32, typeInt64, "gx:Int16", "gx:Byte":
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s value: %v", typ, v)
+		}
+		i, err := n.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %v", typ, v)
+		}
+		return []quad.Value{quad.Int(i)}, nil
+	case typeFloat, typeDouble:
+		var (
+			f   float64
+			err error
+		)
+		switch v := v.(type) {
+		case json.Number:
+			f, err = v.Float64()
+		case string:
+			f, err = strconv.ParseFloat(v, 64)
+		default:
+			err = fmt.Errorf("unexpected type")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %v", typ, v)
+		}
+		return []quad.Value{quad.Float(f)}, nil
+	case typeDate, typeTimestamp:
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil, fmt.Errorf("invalid %s value: %v", typ, v)
+		}
+		ms, err := n.Int64()
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s value: %v", typ, v)
+		}
+		return []quad.Value{quad.Time(time.UnixMilli(ms).UTC())}, nil
+	case typeList, typeSet:
+		if _, ok := v.([]interface{}); !ok {
+			return nil, fmt.Errorf("invalid %s value: %v", typ, v)
+		}
+		return decodeValue(v)
+	case typeProperty, typeVProperty:
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid %s value: %v", typ, v)
+		}
+		return decodeValue(m["value"])
+	}
+	switch v := v.(type) {
+	case string, json.Number, bool:
+		// g:UUID and other types with scalar values
+		return decodeValue(v)
+	}
+	return nil, fmt.Errorf("unsupported type: %s", typ)
+}
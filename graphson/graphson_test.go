@@ -0,0 +1,150 @@
+package graphson_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/graphson"
+	"github.com/cayleygraph/quad/voc/rdf"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+var (
+	rdfType      = quad.IRI(rdf.Type).Full()
+	rdfStatement = quad.IRI(rdf.Statement).Full()
+)
+
+var testQuads = []quad.Quad{
+	{Subject: iri("alice"), Predicate: rdfType, Object: iri("Person")},
+	{Subject: iri("alice"), Predicate: iri("age"), Object: quad.Int(30)},
+	{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String("Alice")},
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")},
+	{Subject: quad.BNode("bob"), Predicate: iri("born"), Object: quad.Time(time.Date(2000, 1, 2, 3, 4, 5, 6e6, time.UTC))},
+	{Subject: quad.BNode("bob"), Predicate: iri("ok"), Object: quad.Bool(true)},
+	{Subject: quad.BNode("bob"), Predicate: iri("score"), Object: quad.Float(2.5)},
+	{Subject: quad.BNode("bob"), Predicate: iri("score"), Object: quad.Float(3)},
+}
+
+var testStar = []quad.Quad{
+	{Subject: quad.Triple{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")}, Predicate: iri("since"), Object: quad.Int(2010)},
+}
+
+const testGraphSON = `{"id":"http://example.org/alice","label":"http://example.org/Person","outE":{"http://example.org/knows":[{"id":{"@type":"g:Int64","@value":0},"inV":"_:bob","properties":{"http://example.org/since":{"@type":"g:Int64","@value":2010}}}]},"properties":{"http://example.org/age":[{"id":{"@type":"g:Int64","@value":0},"value":{"@type":"g:Int64","@value":30}}],"http://example.org/name":[{"id":{"@type":"g:Int64","@value":1},"value":"Alice"}]}}
+{"id":"_:bob","label":"vertex","inE":{"http://example.org/knows":[{"id":{"@type":"g:Int64","@value":0},"outV":"http://example.org/alice","properties":{"http://example.org/since":{"@type":"g:Int64","@value":2010}}}]},"properties":{"http://example.org/born":[{"id":{"@type":"g:Int64","@value":2},"value":{"@type":"g:Date","@value":946782245006}}],"http://example.org/ok":[{"id":{"@type":"g:Int64","@value":3},"value":true}],"http://example.org/score":[{"id":{"@type":"g:Int64","@value":4},"value":{"@type":"g:Double","@value":2.5}},{"id":{"@type":"g:Int64","@value":5},"value":{"@type":"g:Double","@value":3}}]}}
+`
+
+func TestWriteRead(t *testing.T) {
+	// edge properties follow their edges
+	in := append(append(append([]quad.Quad{}, testQuads[:4]...), testStar...), testQuads[4:]...)
+	var buf bytes.Buffer
+	w := graphson.NewWriter(&buf, nil)
+	n, err := w.WriteQuads(in)
+	require.NoError(t, err)
+	require.Equal(t, len(in), n)
+	require.NoError(t, w.Close())
+	require.Equal(t, testGraphSON, buf.String())
+
+	got, err := quad.ReadAll(graphson.NewReader(&buf, nil))
+	require.NoError(t, err)
+	require.Equal(t, in, got)
+}
+
+// reify returns a reified statement for the edge from alice to bob.
+func reify(e quad.Value) []quad.Quad {
+	return []quad.Quad{
+		{Subject: e, Predicate: rdfType, Object: rdfStatement},
+		{Subject: e, Predicate: quad.IRI(rdf.Subject).Full(), Object: iri("alice")},
+		{Subject: e, Predicate: quad.IRI(rdf.Predicate).Full(), Object: iri("knows")},
+		{Subject: e, Predicate: quad.IRI(rdf.Object).Full(), Object: quad.BNode("bob")},
+		{Subject: e, Predicate: iri("since"), Object: quad.Int(2010)},
+	}
+}
+
+func TestReification(t *testing.T) {
+	in := append(append([]quad.Quad{}, testQuads...), reify(quad.BNode("e1"))...)
+	var buf bytes.Buffer
+	w := graphson.NewWriter(&buf, &graphson.Options{EdgeProperties: graphson.Reification})
+	_, err := w.WriteQuads(in)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, testGraphSON, buf.String())
+
+	got, err := quad.ReadAll(graphson.NewReader(&buf, &graphson.ReaderOptions{EdgeProperties: graphson.Reification}))
+	require.NoError(t, err)
+	// statements follow their edges
+	e := got[4].Subject
+	require.IsType(t, quad.BNode(""), e)
+	expect := append(append(append([]quad.Quad{}, testQuads[:4]...), reify(e)...), testQuads[4:]...)
+	require.Equal(t, expect, got)
+
+	got, err = quad.ReadAll(graphson.NewReader(strings.NewReader(testGraphSON), &graphson.ReaderOptions{EdgeProperties: graphson.None}))
+	require.NoError(t, err)
+	require.Equal(t, testQuads, got)
+}
+
+func TestReificationLabels(t *testing.T) {
+	const data = `{"id":"e1","outE":{"http://example.org/p":[{"id":0,"inV":"e2","properties":{"http://example.org/w":1}}]}}`
+	got, err := quad.ReadAll(graphson.NewReader(strings.NewReader(data), &graphson.ReaderOptions{EdgeProperties: graphson.Reification}))
+	require.NoError(t, err)
+	require.Len(t, got, 6)
+	require.Equal(t, quad.BNode("e1"), got[0].Subject)
+	require.NotEqual(t, got[0].Subject, got[1].Subject)
+	require.NotEqual(t, got[0].Object, got[1].Subject)
+}
+
+// TinkerPop modern graph, as written by GraphSONWriter.
+const testModern = `{"id":{"@type":"g:Int32","@value":1},"label":"person","outE":{"created":[{"id":{"@type":"g:Int32","@value":9},"inV":{"@type":"g:Int32","@value":3},"properties":{"weight":{"@type":"g:Double","@value":0.4}}}]},"properties":{"name":[{"id":{"@type":"g:Int64","@value":0},"value":"marko"}],"age":[{"id":{"@type":"g:Int64","@value":1},"value":{"@type":"g:Int32","@value":29}}]}}
+{"id":{"@type":"g:Int32","@value":3},"label":"software","inE":{"created":[{"id":{"@type":"g:Int32","@value":9},"outV":{"@type":"g:Int32","@value":1},"properties":{"weight":{"@type":"g:Double","@value":0.4}}}]},"properties":{"name":[{"id":{"@type":"g:Int64","@value":4},"value":"lop"}],"lang":[{"id":{"@type":"g:Int64","@value":5},"value":"java"}]}}
+`
+
+func TestWriteTypes(t *testing.T) {
+	var buf bytes.Buffer
+	w := graphson.NewWriter(&buf, nil)
+	_, err := w.WriteQuads([]quad.Quad{
+		{Subject: iri("alice"), Predicate: rdfType, Object: iri("Person")},
+		{Subject: iri("alice"), Predicate: quad.IRI(rdf.Type), Object: iri("Agent")},
+	})
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, `{"id":"http://example.org/alice","label":"http://example.org/Person"}`+"\n", buf.String())
+}
+
+func TestReadBase(t *testing.T) {
+	got, err := quad.ReadAll(graphson.NewReader(strings.NewReader(testModern), &graphson.ReaderOptions{Base: "http://example.org/"}))
+	require.NoError(t, err)
+	v1, v3 := iri("1"), iri("3")
+	require.Equal(t, []quad.Quad{
+		{Subject: v1, Predicate: rdfType, Object: iri("person")},
+		{Subject: v1, Predicate: iri("age"), Object: quad.Int(29)},
+		{Subject: v1, Predicate: iri("name"), Object: quad.String("marko")},
+		{Subject: v1, Predicate: iri("created"), Object: v3},
+		{Subject: quad.Triple{Subject: v1, Predicate: iri("created"), Object: v3}, Predicate: iri("weight"), Object: quad.Float(0.4)},
+		{Subject: v3, Predicate: rdfType, Object: iri("software")},
+		{Subject: v3, Predicate: iri("lang"), Object: quad.String("java")},
+		{Subject: v3, Predicate: iri("name"), Object: quad.String("lop")},
+	}, got)
+
+	got, err = quad.ReadAll(graphson.NewReader(strings.NewReader(testModern), nil))
+	require.NoError(t, err)
+	require.Equal(t, quad.BNode("1"), got[0].Subject)
+	require.Equal(t, graphson.DefaultBase+"person", got[0].Object)
+	require.Equal(t, graphson.DefaultBase+"age", got[1].Predicate)
+}
+
+func TestReadErrors(t *testing.T) {
+	for _, s := range []string{
+		`{"id":{"a":1}}`,
+		`{"id":1,"properties":{"x":[{"value":{"@type":"g:Int64","@value":"x"}}]}}`,
+		`{"id":1,"properties":{"x":[{"value":{"@type":"g:Custom","@value":{}}}]}}`,
+		`{"id":1`,
+	} {
+		_, err := quad.ReadAll(graphson.NewReader(strings.NewReader(s), nil))
+		require.Error(t, err, s)
+	}
+}
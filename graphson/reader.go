@@ -0,0 +1,207 @@
+package graphson
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/bnode"
+)
+
+// ReaderOptions for the GraphSON decoder.
+type ReaderOptions struct {
+	// Base is prepended to vertex ids, labels and property keys that are not IRIs.
+	// If it's not set, vertices with such ids are returned as blank nodes, and DefaultBase is used
+	// for labels and property keys.
+	Base quad.IRI
+	// EdgeProperties is a convention for edge properties. Default is Star.
+	EdgeProperties EdgeProperties
+}
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+// Reader implements GraphSON 3.0 document parsing.
+//
+// Vertex ids that start with "_:" are returned as blank nodes, and ids that contain a colon are returned as IRIs.
+// Vertex labels are returned as rdf:type values, unless the label is DefaultLabel. Vertex properties are returned
+// as literal properties, with g:Int32 and g:Int64 values returned as quad.Int, g:Float and g:Double values
+// as quad.Float, and g:Date and g:Timestamp values as quad.Time. Only outgoing edges of each vertex are returned,
+// with edge labels as predicates. Edge properties are returned according to ReaderOptions.
+type Reader struct {
+	dec  *json.Decoder
+	opts ReaderOptions
+	err  error
+
+	n   int // vertex number
+	seq bnode.Generator
+	buf []quad.Quad
+}
+
+// NewReader returns a GraphSON decoder that takes its input from the provided io.Reader.
+// Options can be nil.
+func NewReader(r io.Reader, opts *ReaderOptions) *Reader {
+	if opts == nil {
+		opts = &ReaderOptions{}
+	}
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	return &Reader{dec: dec, opts: *opts}
+}
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("vertex %d: "+format, append([]interface{}{r.n}, args...)...)
+}
+
+// iri returns an IRI for a label or a property key.
+func (r *Reader) iri(s string) quad.IRI {
+	if strings.Contains(s, ":") {
+		return quad.IRI(s)
+	} else if r.opts.Base != "" {
+		return r.opts.Base + quad.IRI(s)
+	}
+	return DefaultBase + quad.IRI(s)
+}
+
+// node returns a value for a vertex id.
+func (r *Reader) node(id interface{}) (quad.Value, error) {
+	vals, err := decodeValue(id)
+	if err != nil {
+		return nil, err
+	} else if len(vals) != 1 {
+		return nil, fmt.Errorf("invalid vertex id: %v", id)
+	}
+	var s string
+	switch v := vals[0].(type) {
+	case quad.String:
+		s = string(v)
+	case quad.Int:
+		s = strconv.FormatInt(int64(v), 10)
+	default:
+		return nil, fmt.Errorf("invalid vertex id: %v", id)
+	}
+	switch {
+	case s == "":
+		return nil, fmt.Errorf("empty vertex id")
+	case strings.HasPrefix(s, "_:"):
+		return quad.BNode(s[2:]), nil
+	case strings.Contains(s, ":") || r.opts.Base != "":
+		return r.iri(s), nil
+	}
+	return quad.BNode(s), nil
+}
+
+// sortedKeys returns keys of a map in a stable order.
+func sortedKeys(m interface{}) []string {
+	mv := reflect.ValueOf(m)
+	keys := make([]string, 0, mv.Len())
+	for _, k := range mv.MapKeys() {
+		keys = append(keys, k.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// next converts a single vertex to quads.
+func (r *Reader) next() error {
+	var v vertexJSON
+	if err := r.dec.Decode(&v); err != nil {
+		if err != io.EOF {
+			err = r.errorf("%w", err)
+		}
+		return err
+	}
+	r.n++
+	s, err := r.node(v.ID)
+	if err != nil {
+		return r.errorf("%w", err)
+	}
+	if v.Label != "" && v.Label != DefaultLabel {
+		r.buf = append(r.buf, quad.Quad{Subject: s, Predicate: iriType, Object: r.iri(v.Label)})
+	}
+	for _, k := range sortedKeys(v.Properties) {
+		p := r.iri(k)
+		for _, prop := range v.Properties[k] {
+			vals, err := decodeValue(prop.Value)
+			if err != nil {
+				return r.errorf("property %q: %w", k, err)
+			}
+			for _, o := range vals {
+				r.buf = append(r.buf, quad.Quad{Subject: s, Predicate: p, Object: o})
+			}
+		}
+	}
+	for _, k := range sortedKeys(v.OutE) {
+		p := r.iri(k)
+		for _, e := range v.OutE[k] {
+			o, err := r.node(e.InV)
+			if err != nil {
+				return r.errorf("edge %q: %w", k, err)
+			}
+			r.buf = append(r.buf, quad.Quad{Subject: s, Predicate: p, Object: o})
+			if err = r.edgeProperties(quad.Triple{Subject: s, Predicate: p, Object: o}, e.Properties); err != nil {
+				return r.errorf("edge %q: %w", k, err)
+			}
+		}
+	}
+	return nil
+}
+
+// edgeProperties emits properties of an edge.
+func (r *Reader) edgeProperties(t quad.Triple, props map[string]interface{}) error {
+	if len(props) == 0 || r.opts.EdgeProperties == None {
+		return nil
+	}
+	var s quad.Value = t
+	if r.opts.EdgeProperties == Reification {
+		s = r.seq.Next()
+		r.buf = append(r.buf,
+			quad.Quad{Subject: s, Predicate: iriType, Object: iriStatement},
+			quad.Quad{Subject: s, Predicate: iriSubject, Object: t.Subject},
+			quad.Quad{Subject: s, Predicate: iriPredicate, Object: t.Predicate},
+			quad.Quad{Subject: s, Predicate: iriObject, Object: t.Object},
+		)
+	}
+	for _, k := range sortedKeys(props) {
+		vals, err := decodeValue(props[k])
+		if err != nil {
+			return fmt.Errorf("property %q: %w", k, err)
+		}
+		for _, o := range vals {
+			r.buf = append(r.buf, quad.Quad{Subject: s, Predicate: r.iri(k), Object: o})
+		}
+	}
+	return nil
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	for len(r.buf) == 0 && r.err == nil {
+		r.err = r.next()
+	}
+	if len(r.buf) != 0 {
+		q := r.buf[0]
+		r.buf = r.buf[1:]
+		return q, nil
+	}
+	return quad.Quad{}, r.err
+}
+
+// ReadQuads implements quad.BatchReader.
+func (r *Reader) ReadQuads(buf []quad.Quad) (int, error) {
+	for i := range buf {
+		q, err := r.ReadQuad()
+		if err != nil {
+			return i, err
+		}
+		buf[i] = q
+	}
+	return len(buf), nil
+}
+
+// Close implements quad.ReadCloser.
+func (r *Reader) Close() error { return nil }
@@ -0,0 +1,321 @@
+package graphson
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/pgraph"
+)
+
+// Options for the GraphSON encoder.
+type Options struct {
+	// EdgeProperties is a convention for edge properties. Default is Star.
+	EdgeProperties EdgeProperties
+}
+
+// vertex is a vertex with its properties and edges.
+type vertex struct {
+	id    string
+	label string
+	keys  []string // property keys in order of appearance
+	props map[string][]quad.Value
+	out   []*edge
+	in    []*edge
+}
+
+// edge is an edge with its properties.
+type edge struct {
+	id       int64
+	label    string
+	src, dst *vertex
+	props    map[string]quad.Value
+}
+
+// graph is an in-memory property graph.
+type graph struct {
+	order    []*vertex
+	vertices map[string]*vertex
+	edges    map[string]*edge
+}
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns a GraphSON encoder that writes its output to the provided io.Writer.
+// Options can be nil.
+func NewWriter(w io.Writer, opts *Options) *Writer {
+	if opts == nil {
+		opts = &Options{}
+	}
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	return &Writer{w: bw, enc: enc, opts: *opts}
+}
+
+// Writer implements GraphSON 3.0 document generator.
+//
+// Each IRI or blank node is written as a vertex line, and its string id is how readers connect edges
+// across lines. TinkerPop vertices have a single label, so only the first rdf:type value is used, other
+// types are dropped, and vertices without types get DefaultLabel. Literal properties become vertex properties keyed by full
+// predicate IRIs. quad.Int values are written as g:Int64, quad.Float as g:Double, and quad.Time as g:Date
+// with millisecond precision. Other literals are written as strings. Quads with IRI or blank node objects
+// become edges labeled with full predicate IRIs. Edge properties are recognized according to Options and
+// must be literals with a single value per key. TinkerPop graphs are not partitioned, so quad labels are dropped.
+//
+// Every edge is listed in outE of its source and in inE of its target, so no line can be written before Close.
+type Writer struct {
+	w    *bufio.Writer
+	enc  *json.Encoder
+	opts Options
+	err  error
+
+	quads []quad.Quad
+}
+
+// isIRI checks if a value is an IRI equal to the full IRI.
+func isIRI(v quad.Value, iri quad.IRI) bool {
+	p, ok := v.(quad.IRI)
+	return ok && p.Full() == iri
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	} else if _, ok := q.Object.(quad.Triple); ok {
+		return fmt.Errorf("quoted triples are not supported as objects: %v", q.Object)
+	}
+	if _, ok := q.Subject.(quad.Triple); ok {
+		switch w.opts.EdgeProperties {
+		case None:
+			return nil
+		case Reification:
+			return fmt.Errorf("quoted triples are not supported: %v", q.Subject)
+		}
+	}
+	w.quads = append(w.quads, q)
+	return nil
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+// vertex returns a vertex for a value, adding it if necessary.
+func (g *graph) vertex(v quad.Value) *vertex {
+	id := pgraph.NodeID(v)
+	n, ok := g.vertices[id]
+	if !ok {
+		n = &vertex{id: id}
+		g.vertices[id] = n
+		g.order = append(g.order, n)
+	}
+	return n
+}
+
+// edge returns an edge for a triple, adding it if necessary. Duplicate edges are merged.
+func (g *graph) edge(t quad.Triple) (*edge, error) {
+	if !pgraph.IsNode(t.Object) {
+		return nil, fmt.Errorf("edge properties are only supported for node objects: %v", t)
+	}
+	key := t.String()
+	if e, ok := g.edges[key]; ok {
+		return e, nil
+	}
+	e := &edge{
+		id: int64(len(g.edges)), label: pgraph.NodeID(t.Predicate),
+		src: g.vertex(t.Subject), dst: g.vertex(t.Object),
+	}
+	e.src.out = append(e.src.out, e)
+	e.dst.in = append(e.dst.in, e)
+	g.edges[key] = e
+	return e, nil
+}
+
+// setProperty sets an edge property. Edge properties can only have a single value.
+func (e *edge) setProperty(p, v quad.Value) error {
+	if pgraph.IsNode(v) {
+		return fmt.Errorf("edge properties must be literals: %v", v)
+	}
+	key := pgraph.NodeID(p)
+	if e.props == nil {
+		e.props = make(map[string]quad.Value)
+	} else if _, ok := e.props[key]; ok {
+		return fmt.Errorf("multiple values of edge property %q", key)
+	}
+	e.props[key] = v
+	return nil
+}
+
+// statements finds reified statements. Nodes with a single subject, predicate and object are used as edges.
+func (w *Writer) statements() map[string]quad.Triple {
+	type parts struct {
+		s, p, o []quad.Value
+	}
+	all := make(map[string]*parts)
+	for _, q := range w.quads {
+		id := pgraph.NodeID(q.Subject)
+		p := all[id]
+		switch {
+		case isIRI(q.Predicate, iriSubject), isIRI(q.Predicate, iriPredicate), isIRI(q.Predicate, iriObject):
+			if p == nil {
+				p = &parts{}
+				all[id] = p
+			}
+		default:
+			continue
+		}
+		switch {
+		case isIRI(q.Predicate, iriSubject):
+			p.s = append(p.s, q.Object)
+		case isIRI(q.Predicate, iriPredicate):
+			p.p = append(p.p, q.Object)
+		default:
+			p.o = append(p.o, q.Object)
+		}
+	}
+	out := make(map[string]quad.Triple)
+	for id, p := range all {
+		if len(p.s) != 1 || len(p.p) != 1 || len(p.o) != 1 || !pgraph.IsNode(p.s[0]) || !pgraph.IsNode(p.o[0]) {
+			continue
+		} else if _, ok := p.p[0].(quad.IRI); !ok {
+			continue
+		}
+		out[id] = quad.Triple{Subject: p.s[0], Predicate: p.p[0], Object: p.o[0]}
+	}
+	return out
+}
+
+// build converts quads to a property graph.
+func (w *Writer) build() (*graph, error) {
+	g := &graph{vertices: make(map[string]*vertex), edges: make(map[string]*edge)}
+	var stmts map[string]quad.Triple
+	if w.opts.EdgeProperties == Reification {
+		stmts = w.statements()
+	}
+	for _, q := range w.quads {
+		if t, ok := q.Subject.(quad.Triple); ok {
+			e, err := g.edge(t)
+			if err != nil {
+				return nil, err
+			} else if err = e.setProperty(q.Predicate, q.Object); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if t, ok := stmts[pgraph.NodeID(q.Subject)]; ok {
+			e, err := g.edge(t)
+			if err != nil {
+				return nil, err
+			}
+			switch {
+			case isIRI(q.Predicate, iriSubject), isIRI(q.Predicate, iriPredicate), isIRI(q.Predicate, iriObject):
+			case isIRI(q.Predicate, iriType) && isIRI(q.Object, iriStatement):
+			default:
+				if err = e.setProperty(q.Predicate, q.Object); err != nil {
+					return nil, err
+				}
+			}
+			continue
+		}
+		v := g.vertex(q.Subject)
+		if isIRI(q.Predicate, iriType) && pgraph.IsNode(q.Object) {
+			if v.label == "" {
+				v.label = pgraph.NodeID(q.Object)
+			}
+		} else if pgraph.IsNode(q.Object) {
+			if _, err := g.edge(quad.Triple{Subject: q.Subject, Predicate: q.Predicate, Object: q.Object}); err != nil {
+				return nil, err
+			}
+		} else {
+			key := pgraph.NodeID(q.Predicate)
+			if v.props == nil {
+				v.props = make(map[string][]quad.Value)
+			}
+			if _, ok := v.props[key]; !ok {
+				v.keys = append(v.keys, key)
+			}
+			v.props[key] = append(v.props[key], q.Object)
+		}
+	}
+	return g, nil
+}
+
+// toJSON returns an edge object for an adjacency list of the source or the target vertex.
+func (e *edge) toJSON(in bool) edgeJSON {
+	ej := edgeJSON{ID: typed{Type: typeInt64, Value: e.id}}
+	if in {
+		ej.OutV = e.src.id
+	} else {
+		ej.InV = e.dst.id
+	}
+	if len(e.props) != 0 {
+		ej.Properties = make(map[string]interface{}, len(e.props))
+		for k, v := range e.props {
+			ej.Properties[k] = encodeValue(v)
+		}
+	}
+	return ej
+}
+
+// Close writes the graph and flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	g, err := w.build()
+	if err != nil {
+		w.err = err
+		return err
+	}
+	w.quads = nil
+	var pid int64 // vertex property ids
+	for _, v := range g.order {
+		vj := vertexJSON{ID: v.id, Label: v.label}
+		if vj.Label == "" {
+			vj.Label = DefaultLabel
+		}
+		for _, e := range v.out {
+			if vj.OutE == nil {
+				vj.OutE = make(map[string][]edgeJSON)
+			}
+			vj.OutE[e.label] = append(vj.OutE[e.label], e.toJSON(false))
+		}
+		for _, e := range v.in {
+			if vj.InE == nil {
+				vj.InE = make(map[string][]edgeJSON)
+			}
+			vj.InE[e.label] = append(vj.InE[e.label], e.toJSON(true))
+		}
+		if len(v.keys) != 0 {
+			vj.Properties = make(map[string][]propertyJSON, len(v.keys))
+			for _, k := range v.keys {
+				for _, val := range v.props[k] {
+					vj.Properties[k] = append(vj.Properties[k], propertyJSON{
+						ID: typed{Type: typeInt64, Value: pid}, Value: encodeValue(val),
+					})
+					pid++
+				}
+			}
+		}
+		if w.err = w.enc.Encode(vj); w.err != nil {
+			return w.err
+		}
+	}
+	if w.err = w.w.Flush(); w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	return nil
+}
@@ -0,0 +1,200 @@
+package hdt
+
+import (
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"math/bits"
+	"sort"
+)
+
+var castagnoli = crc32.MakeTable(crc32.Castagnoli)
+
+// crc8 computes CRC-8-CCITT checksum used for headers of HDT structures.
+func crc8(b []byte) byte {
+	var crc byte
+	for _, c := range b {
+		crc ^= c
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// crc16 computes CRC-16-ANSI checksum used for control information.
+func crc16(b []byte) uint16 {
+	var crc uint16
+	for _, c := range b {
+		crc ^= uint16(c)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xa001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
+
+// crc32c computes CRC-32C checksum used for data of HDT structures.
+func crc32c(b []byte) uint32 {
+	return crc32.Checksum(b, castagnoli)
+}
+
+// appendVByte appends a variable-length integer. Unlike protobuf varints,
+// the high bit is set on the last byte of the number.
+func appendVByte(b []byte, v uint64) []byte {
+	for v > 0x7f {
+		b = append(b, byte(v&0x7f))
+		v >>= 7
+	}
+	return append(b, byte(v)|0x80)
+}
+
+// readVByte reads a variable-length integer from the buffer. It returns the number of bytes read,
+// or zero if the number is malformed.
+func readVByte(b []byte) (uint64, int) {
+	var v uint64
+	for i, c := range b {
+		if i*7 >= 64 {
+			return 0, 0
+		}
+		v |= uint64(c&0x7f) << uint(i*7)
+		if c&0x80 != 0 {
+			return v, i + 1
+		}
+	}
+	return 0, 0
+}
+
+// wordsOf converts little-endian bytes to a slice of 64 bit words.
+func wordsOf(b []byte) []uint64 {
+	w := make([]uint64, (len(b)+7)/8)
+	for i := range w {
+		var buf [8]byte
+		copy(buf[:], b[i*8:])
+		w[i] = binary.LittleEndian.Uint64(buf[:])
+	}
+	return w
+}
+
+// bytesOf converts a slice of 64 bit words to n little-endian bytes.
+func bytesOf(w []uint64, n uint64) []byte {
+	b := make([]byte, len(w)*8)
+	for i, v := range w {
+		binary.LittleEndian.PutUint64(b[i*8:], v)
+	}
+	return b[:n]
+}
+
+// bitsFor returns the number of bits required to store v.
+func bitsFor(v uint64) uint8 {
+	return uint8(bits.Len64(v))
+}
+
+// logArray is a sequence of integers of a fixed bit width.
+type logArray struct {
+	width uint8
+	n     uint64
+	words []uint64
+}
+
+func newLogArray(width uint8, n uint64) *logArray {
+	return &logArray{width: width, n: n, words: make([]uint64, (uint64(width)*n+63)/64)}
+}
+
+// size returns the number of bytes used to store the data of the array.
+func (a *logArray) size() uint64 {
+	return (uint64(a.width)*a.n + 7) / 8
+}
+
+func (a *logArray) mask() uint64 {
+	if a.width == 64 {
+		return ^uint64(0)
+	}
+	return 1<<a.width - 1
+}
+
+func (a *logArray) get(i uint64) uint64 {
+	if a.width == 0 {
+		return 0
+	}
+	bit := i * uint64(a.width)
+	w, off := bit/64, bit%64
+	v := a.words[w] >> off
+	if off+uint64(a.width) > 64 {
+		v |= a.words[w+1] << (64 - off)
+	}
+	return v & a.mask()
+}
+
+func (a *logArray) set(i, v uint64) {
+	if a.width == 0 {
+		return
+	}
+	v &= a.mask()
+	bit := i * uint64(a.width)
+	w, off := bit/64, bit%64
+	a.words[w] = a.words[w]&^(a.mask()<<off) | v<<off
+	if off+uint64(a.width) > 64 {
+		rest := 64 - off
+		a.words[w+1] = a.words[w+1]&^(a.mask()>>rest) | v>>rest
+	}
+}
+
+// bitmap is a sequence of bits with rank and select support.
+type bitmap struct {
+	n     uint64
+	words []uint64
+	ranks []uint64 // number of set bits before each word
+}
+
+func newBitmap(n uint64) *bitmap {
+	return &bitmap{n: n, words: make([]uint64, (n+63)/64)}
+}
+
+// size returns the number of bytes used to store the data of the bitmap.
+func (b *bitmap) size() uint64 {
+	return (b.n + 7) / 8
+}
+
+func (b *bitmap) set(i uint64) {
+	b.words[i/64] |= 1 << (i % 64)
+}
+
+func (b *bitmap) get(i uint64) bool {
+	return b.words[i/64]&(1<<(i%64)) != 0
+}
+
+// index builds rank directory of the bitmap. It must be called after all bits are set.
+func (b *bitmap) index() {
+	b.ranks = make([]uint64, len(b.words)+1)
+	for i, w := range b.words {
+		b.ranks[i+1] = b.ranks[i] + uint64(bits.OnesCount64(w))
+	}
+}
+
+// ones returns the number of set bits.
+func (b *bitmap) ones() uint64 {
+	return b.ranks[len(b.words)]
+}
+
+// select1 returns the position of the k-th set bit, starting from 1.
+func (b *bitmap) select1(k uint64) (uint64, error) {
+	if k == 0 || k > b.ones() {
+		return 0, fmt.Errorf("bitmap position %d is out of range", k)
+	}
+	// word that contains the k-th set bit
+	w := sort.Search(len(b.words), func(i int) bool { return b.ranks[i+1] >= k })
+	v := b.words[w]
+	for k -= b.ranks[w]; k > 1; k-- {
+		v &= v - 1
+	}
+	return uint64(w)*64 + uint64(bits.TrailingZeros64(v)), nil
+}
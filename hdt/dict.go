@@ -0,0 +1,193 @@
+package hdt
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// section is a dictionary section with strings encoded using plain front coding.
+//
+// Strings are sorted and split into blocks. The first string of each block is stored as is,
+// and the rest are stored as a length of a prefix shared with a previous string and a suffix.
+type section struct {
+	n         uint64
+	blockSize uint64
+	blocks    *logArray // offsets of blocks in data, with the end of data as the last entry
+	data      []byte
+}
+
+// newSection encodes sorted strings as a dictionary section.
+func newSection(strs []string, blockSize uint64) *section {
+	s := &section{n: uint64(len(strs)), blockSize: blockSize}
+	var offs []uint64
+	var prev string
+	for i, str := range strs {
+		if uint64(i)%blockSize == 0 {
+			offs = append(offs, uint64(len(s.data)))
+			s.data = append(s.data, str...)
+		} else {
+			n := 0
+			for n < len(str) && n < len(prev) && str[n] == prev[n] {
+				n++
+			}
+			s.data = appendVByte(s.data, uint64(n))
+			s.data = append(s.data, str[n:]...)
+		}
+		s.data = append(s.data, 0)
+		prev = str
+	}
+	offs = append(offs, uint64(len(s.data)))
+	s.blocks = newLogArray(bitsFor(uint64(len(s.data))), uint64(len(offs)))
+	for i, off := range offs {
+		s.blocks.set(uint64(i), off)
+	}
+	return s
+}
+
+// block iterates over strings of a block, starting from the first one.
+type block struct {
+	s    *section
+	off  uint64
+	cur  []byte
+	err  error
+	head bool
+}
+
+func (s *section) block(i uint64) *block {
+	return &block{s: s, off: s.blocks.get(i), head: true}
+}
+
+// next decodes the next string of the block.
+func (b *block) next() bool {
+	if b.err != nil {
+		return false
+	}
+	data := b.s.data
+	if b.off >= uint64(len(data)) {
+		b.err = fmt.Errorf("dictionary section is truncated")
+		return false
+	}
+	if b.head {
+		b.head = false
+		b.cur = b.cur[:0]
+	} else {
+		n, sz := readVByte(data[b.off:])
+		if sz == 0 || n > uint64(len(b.cur)) {
+			b.err = fmt.Errorf("malformed dictionary section")
+			return false
+		}
+		b.off += uint64(sz)
+		b.cur = b.cur[:n]
+	}
+	i := bytes.IndexByte(data[b.off:], 0)
+	if i < 0 {
+		b.err = fmt.Errorf("dictionary section is truncated")
+		return false
+	}
+	b.cur = append(b.cur, data[b.off:b.off+uint64(i)]...)
+	b.off += uint64(i) + 1
+	return true
+}
+
+// extract returns a string by its id, starting from 1.
+func (s *section) extract(id uint64) (string, error) {
+	if id == 0 || id > s.n {
+		return "", fmt.Errorf("dictionary id %d is out of range", id)
+	}
+	b := s.block((id - 1) / s.blockSize)
+	for i := uint64(0); i <= (id-1)%s.blockSize; i++ {
+		if !b.next() {
+			return "", b.err
+		}
+	}
+	return string(b.cur), nil
+}
+
+// locate returns an id of a string, or 0 if it's not in the section.
+func (s *section) locate(str string) (uint64, error) {
+	if s.n == 0 {
+		return 0, nil
+	}
+	nb := int(s.blocks.n - 1)
+	var err error
+	// first block with a head greater than the string
+	i := sort.Search(nb, func(i int) bool {
+		b := s.block(uint64(i))
+		if !b.next() {
+			err = b.err
+			return true
+		}
+		return string(b.cur) > str
+	})
+	if err != nil {
+		return 0, err
+	} else if i == 0 {
+		return 0, nil
+	}
+	i--
+	b := s.block(uint64(i))
+	for j := uint64(0); j < s.blockSize; j++ {
+		id := uint64(i)*s.blockSize + j + 1
+		if id > s.n || !b.next() {
+			break
+		}
+		if c := string(b.cur); c == str {
+			return id, nil
+		} else if c > str {
+			break
+		}
+	}
+	return 0, b.err
+}
+
+// dictionary maps terms to ids.
+//
+// Terms used both as subjects and objects are stored in the shared section and have the same ids
+// in both roles. Ids of subject-only and object-only terms follow the ids of shared terms.
+// Predicates are stored separately.
+type dictionary struct {
+	shared, subjects, predicates, objects *section
+}
+
+func (d *dictionary) subjectID(str string) (uint64, error) {
+	return d.roleID(d.subjects, str)
+}
+
+func (d *dictionary) objectID(str string) (uint64, error) {
+	return d.roleID(d.objects, str)
+}
+
+func (d *dictionary) predicateID(str string) (uint64, error) {
+	return d.predicates.locate(str)
+}
+
+func (d *dictionary) roleID(s *section, str string) (uint64, error) {
+	if id, err := d.shared.locate(str); err != nil || id != 0 {
+		return id, err
+	}
+	id, err := s.locate(str)
+	if err != nil || id == 0 {
+		return 0, err
+	}
+	return d.shared.n + id, nil
+}
+
+func (d *dictionary) subject(id uint64) (string, error) {
+	return d.role(d.subjects, id)
+}
+
+func (d *dictionary) object(id uint64) (string, error) {
+	return d.role(d.objects, id)
+}
+
+func (d *dictionary) predicate(id uint64) (string, error) {
+	return d.predicates.extract(id)
+}
+
+func (d *dictionary) role(s *section, id uint64) (string, error) {
+	if id <= d.shared.n {
+		return d.shared.extract(id)
+	}
+	return s.extract(id - d.shared.n)
+}
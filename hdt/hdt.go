@@ -0,0 +1,102 @@
+// Package hdt implements the HDT (Header-Dictionary-Triples) binary RDF format.
+//
+// HDT file consists of a header with dataset metadata, a dictionary that maps terms to integer ids
+// and a compressed bitmap index of triples sorted by subject, predicate and object.
+// The index allows answering triple pattern queries without decoding the whole file.
+//
+// Only the "four section" dictionary and bitmap triples in SPO order are supported, which are
+// the defaults of the reference implementations. HDT has no notion of named graphs.
+//
+// See https://www.w3.org/Submission/HDT/ for the format specification.
+package hdt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+)
+
+// AutoConvertTypedString allows to convert TypedString values to native
+// equivalents directly while parsing. It will call ToNative on all TypedString values.
+//
+// If conversion error occurs, it will preserve original TypedString value.
+var AutoConvertTypedString = true
+
+// ContentType is a media type of HDT files.
+const ContentType = "application/vnd.hdt"
+
+const (
+	formatHDT            = "<http://purl.org/HDT/hdt#HDTv1>"
+	formatHeader         = "ntriples"
+	formatDictionaryFour = "<http://purl.org/HDT/hdt#dictionaryFour>"
+	formatTriplesBitmap  = "<http://purl.org/HDT/hdt#triplesBitmap>"
+
+	orderSPO = "1"
+)
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name: "hdt", Binary: true,
+		Ext:    []string{".hdt"},
+		Mime:   []string{ContentType},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w, nil) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r) },
+		Sniff: func(head []byte) bool {
+			return bytes.HasPrefix(head, []byte(cookie))
+		},
+	})
+}
+
+// termOf returns a dictionary representation of a value.
+func termOf(v quad.Value) (string, error) {
+	if ts, ok := v.(quad.TypedStringer); ok {
+		s := ts.TypedString()
+		s.Type = s.Type.Full()
+		v = s
+	}
+	switch v := v.(type) {
+	case quad.IRI:
+		return string(v.Full()), nil
+	case quad.BNode:
+		return "_:" + string(v), nil
+	case quad.String:
+		return `"` + string(v) + `"`, nil
+	case quad.LangString:
+		return `"` + string(v.Value) + `"@` + v.Lang, nil
+	case quad.TypedString:
+		return `"` + string(v.Value) + `"^^<` + string(v.Type.Full()) + `>`, nil
+	}
+	return "", fmt.Errorf("unsupported value: %v", v)
+}
+
+// valueOf parses a dictionary representation of a value.
+func valueOf(s string) (quad.Value, error) {
+	if strings.HasPrefix(s, "_:") {
+		return quad.BNode(s[2:]), nil
+	} else if !strings.HasPrefix(s, `"`) {
+		return quad.IRI(s), nil
+	}
+	i := strings.LastIndexByte(s, '"')
+	if i == 0 {
+		return nil, fmt.Errorf("malformed literal: %q", s)
+	}
+	val, rest := quad.String(s[1:i]), s[i+1:]
+	switch {
+	case rest == "":
+		return val, nil
+	case strings.HasPrefix(rest, "@"):
+		return quad.LangString{Value: val, Lang: rest[1:]}, nil
+	case strings.HasPrefix(rest, "^^<") && strings.HasSuffix(rest, ">"):
+		ts := quad.TypedString{Value: val, Type: quad.IRI(rest[3 : len(rest)-1])}
+		if AutoConvertTypedString {
+			if nv, err := ts.ParseValue(); err == nil {
+				return nv, nil
+			}
+		}
+		return ts, nil
+	}
+	return nil, fmt.Errorf("malformed literal: %q", s)
+}
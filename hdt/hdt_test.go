@@ -0,0 +1,172 @@
+package hdt_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/hdt"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+var testQuads = []quad.Quad{
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob")},
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("carol")},
+	{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String("Alice \"A\"")},
+	{Subject: iri("bob"), Predicate: iri("knows"), Object: iri("alice")},
+	{Subject: iri("bob"), Predicate: iri("title"), Object: quad.LangString{Value: "Dr", Lang: "en"}},
+	{Subject: quad.BNode("carol"), Predicate: iri("born"), Object: quad.TypedString{Value: "1990-07", Type: "http://www.w3.org/2001/XMLSchema#gYearMonth"}},
+	{Subject: quad.BNode("carol"), Predicate: iri("age"), Object: quad.Int(42)},
+	{Subject: quad.BNode("carol"), Predicate: iri("seen"), Object: quad.Time(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))},
+}
+
+func encode(t testing.TB, opts *hdt.Options, quads []quad.Quad) []byte {
+	buf := bytes.NewBuffer(nil)
+	w := hdt.NewWriter(buf, opts)
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	return buf.Bytes()
+}
+
+func sorted(quads []quad.Quad) []quad.Quad {
+	out := append([]quad.Quad{}, quads...)
+	sort.Slice(out, func(i, j int) bool { return out[i].NQuad() < out[j].NQuad() })
+	return out
+}
+
+func TestRoundTrip(t *testing.T) {
+	data := encode(t, nil, append(testQuads, testQuads[0]))
+
+	r := hdt.NewReader(bytes.NewReader(data))
+	defer r.Close()
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, sorted(testQuads), sorted(got))
+}
+
+func TestSearch(t *testing.T) {
+	doc, err := hdt.Open(bytes.NewReader(encode(t, &hdt.Options{BlockSize: 2}, testQuads)))
+	require.NoError(t, err)
+	require.Equal(t, len(testQuads), doc.Len())
+
+	cases := []struct {
+		s, p, o quad.Value
+		exp     []quad.Quad
+	}{
+		{s: iri("alice"), exp: testQuads[0:3]},
+		{s: iri("alice"), p: iri("knows"), exp: testQuads[0:2]},
+		{s: iri("alice"), p: iri("knows"), o: iri("bob"), exp: testQuads[0:1]},
+		{p: iri("knows"), exp: []quad.Quad{testQuads[0], testQuads[1], testQuads[3]}},
+		{o: iri("alice"), exp: testQuads[3:4]},
+		{o: quad.Int(42), exp: testQuads[6:7]},
+		{p: iri("knows"), o: quad.BNode("carol"), exp: testQuads[1:2]},
+		{s: iri("unknown")},
+		{s: iri("alice"), p: iri("title")},
+		{o: iri("unknown")},
+		{exp: testQuads},
+	}
+	for _, c := range cases {
+		c := c
+		t.Run(fmt.Sprintf("%v %v %v", c.s, c.p, c.o), func(t *testing.T) {
+			got, err := quad.ReadAll(doc.Search(c.s, c.p, c.o))
+			require.NoError(t, err)
+			require.Equal(t, sorted(c.exp), sorted(got))
+		})
+	}
+}
+
+func TestHeader(t *testing.T) {
+	doc, err := hdt.Open(bytes.NewReader(encode(t, &hdt.Options{BaseIRI: iri("dataset")}, testQuads)))
+	require.NoError(t, err)
+	header, err := quad.ReadAll(doc.Header())
+	require.NoError(t, err)
+	require.Contains(t, header, quad.Quad{
+		Subject: iri("dataset"), Predicate: quad.IRI("http://rdfs.org/ns/void#triples"), Object: quad.String("8"),
+	})
+}
+
+// TestReference reads a file with the layout of reference implementations, see testdata/gen.go.
+func TestReference(t *testing.T) {
+	f, err := os.Open("testdata/example.hdt")
+	require.NoError(t, err)
+	defer f.Close()
+	doc, err := hdt.Open(f)
+	require.NoError(t, err)
+	require.Equal(t, 5, doc.Len())
+
+	foaf := func(s string) quad.IRI { return quad.IRI("http://xmlns.com/foaf/0.1/" + s) }
+	all := []quad.Quad{
+		{Subject: quad.BNode("b1"), Predicate: foaf("age"), Object: quad.Int(42)},
+		{Subject: iri("bob"), Predicate: foaf("knows"), Object: quad.BNode("b1")},
+		{Subject: iri("bob"), Predicate: foaf("name"), Object: quad.LangString{Value: "Bob", Lang: "en"}},
+		{Subject: iri("alice"), Predicate: foaf("knows"), Object: iri("bob")},
+		{Subject: iri("alice"), Predicate: foaf("name"), Object: quad.String("Alice")},
+	}
+	got, err := quad.ReadAll(doc.Search(nil, nil, nil))
+	require.NoError(t, err)
+	require.Equal(t, all, got)
+
+	got, err = quad.ReadAll(doc.Search(iri("alice"), foaf("name"), nil))
+	require.NoError(t, err)
+	require.Equal(t, all[4:], got)
+
+	got, err = quad.ReadAll(doc.Search(nil, foaf("knows"), quad.BNode("b1")))
+	require.NoError(t, err)
+	require.Equal(t, all[1:2], got)
+
+	header, err := quad.ReadAll(doc.Header())
+	require.NoError(t, err)
+	require.Contains(t, header, quad.Quad{
+		Subject: iri("dataset"), Predicate: quad.IRI("http://rdfs.org/ns/void#triples"), Object: quad.String("5"),
+	})
+}
+
+func TestLarge(t *testing.T) {
+	var quads []quad.Quad
+	for i := 0; i < 1000; i++ {
+		quads = append(quads, quad.Quad{
+			Subject:   iri(fmt.Sprintf("s%d", i%97)),
+			Predicate: iri(fmt.Sprintf("p%d", i%13)),
+			Object:    iri(fmt.Sprintf("s%d", i%131)),
+		})
+	}
+	doc, err := hdt.Open(bytes.NewReader(encode(t, nil, quads)))
+	require.NoError(t, err)
+	got, err := quad.ReadAll(doc.Search(nil, nil, nil))
+	require.NoError(t, err)
+	require.Equal(t, sorted(quads), sorted(got))
+
+	got, err = quad.ReadAll(doc.Search(iri("s5"), nil, nil))
+	require.NoError(t, err)
+	var exp []quad.Quad
+	for _, q := range quads {
+		if q.Subject == iri("s5") {
+			exp = append(exp, q)
+		}
+	}
+	require.Equal(t, sorted(exp), sorted(got))
+}
+
+func TestCorrupted(t *testing.T) {
+	data := encode(t, nil, testQuads)
+	data[len(data)-5] ^= 0xff
+	_, err := hdt.Open(bytes.NewReader(data))
+	require.Error(t, err)
+
+	_, err = hdt.Open(bytes.NewReader([]byte("not hdt")))
+	require.Error(t, err)
+}
+
+func TestWriterErrors(t *testing.T) {
+	w := hdt.NewWriter(bytes.NewBuffer(nil), nil)
+	require.Error(t, w.WriteQuad(quad.Quad{Subject: iri("a"), Predicate: iri("b"), Object: iri("c"), Label: iri("g")}))
+	require.Error(t, w.WriteQuad(quad.Quad{Subject: quad.String("a"), Predicate: iri("b"), Object: iri("c")}))
+}
@@ -0,0 +1,298 @@
+package hdt
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+const cookie = "$HDT"
+
+// Types of control information blocks.
+const (
+	typeGlobal     = 1
+	typeHeader     = 2
+	typeDictionary = 3
+	typeTriples    = 4
+)
+
+const (
+	typeLogArray = 1 // sequence type
+	typeBitmap   = 1 // bitmap type
+	typePFC      = 2 // dictionary section type
+)
+
+// control is a control information block that precedes every part of HDT file.
+type control struct {
+	typ    byte
+	format string
+	props  map[string]string
+}
+
+// decoder reads HDT structures and validates their checksums.
+type decoder struct {
+	r   *bufio.Reader
+	rec []byte // bytes read since the last call to begin
+	err error
+}
+
+func newDecoder(r io.Reader) *decoder {
+	return &decoder{r: bufio.NewReader(r)}
+}
+
+func (d *decoder) fail(err error) {
+	if d.err != nil {
+		return
+	} else if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	d.err = err
+}
+
+// begin starts recording bytes for a header checksum.
+func (d *decoder) begin() {
+	d.rec = d.rec[:0]
+}
+
+func (d *decoder) byte() byte {
+	if d.err != nil {
+		return 0
+	}
+	c, err := d.r.ReadByte()
+	if err != nil {
+		d.fail(err)
+		return 0
+	}
+	d.rec = append(d.rec, c)
+	return c
+}
+
+func (d *decoder) vbyte() uint64 {
+	var v uint64
+	for i := 0; d.err == nil; i++ {
+		if i*7 >= 64 {
+			d.fail(fmt.Errorf("malformed vbyte number"))
+			break
+		}
+		c := d.byte()
+		v |= uint64(c&0x7f) << uint(i*7)
+		if c&0x80 != 0 {
+			break
+		}
+	}
+	return v
+}
+
+// cstring reads a null-terminated string.
+func (d *decoder) cstring() string {
+	var sb strings.Builder
+	for d.err == nil {
+		c := d.byte()
+		if c == 0 {
+			break
+		}
+		sb.WriteByte(c)
+	}
+	return sb.String()
+}
+
+// data reads n bytes that are not covered by a header checksum.
+func (d *decoder) data(n uint64) []byte {
+	if d.err != nil {
+		return nil
+	}
+	buf := bytes.NewBuffer(nil)
+	if _, err := io.CopyN(buf, d.r, int64(n)); err != nil {
+		d.fail(err)
+		return nil
+	}
+	return buf.Bytes()
+}
+
+func (d *decoder) checkCRC8(what string) {
+	sum := crc8(d.rec)
+	if c := d.data(1); d.err == nil && c[0] != sum {
+		d.fail(fmt.Errorf("checksum mismatch in %s header", what))
+	}
+}
+
+func (d *decoder) checkCRC16(what string) {
+	sum := crc16(d.rec)
+	if c := d.data(2); d.err == nil && binary.LittleEndian.Uint16(c) != sum {
+		d.fail(fmt.Errorf("checksum mismatch in %s control information", what))
+	}
+}
+
+func (d *decoder) checkCRC32(data []byte, what string) {
+	if c := d.data(4); d.err == nil && binary.LittleEndian.Uint32(c) != crc32c(data) {
+		d.fail(fmt.Errorf("checksum mismatch in %s data", what))
+	}
+}
+
+// control reads control information of a given type.
+func (d *decoder) control(typ byte, what string) control {
+	d.begin()
+	var c control
+	magic := []byte{d.byte(), d.byte(), d.byte(), d.byte()}
+	if d.err == nil && string(magic) != cookie {
+		d.fail(fmt.Errorf("not an HDT file"))
+	}
+	c.typ = d.byte()
+	c.format = d.cstring()
+	props := d.cstring()
+	d.checkCRC16(what)
+	if d.err == nil && c.typ != typ {
+		d.fail(fmt.Errorf("unexpected control information type for %s: %d", what, c.typ))
+	}
+	c.props = make(map[string]string)
+	for _, kv := range strings.Split(props, ";") {
+		if i := strings.IndexByte(kv, '='); i > 0 {
+			c.props[kv[:i]] = kv[i+1:]
+		}
+	}
+	return c
+}
+
+func (d *decoder) logArray(what string) *logArray {
+	d.begin()
+	if typ := d.byte(); d.err == nil && typ != typeLogArray {
+		d.fail(fmt.Errorf("unsupported sequence type in %s: %d", what, typ))
+	}
+	width := d.byte()
+	n := d.vbyte()
+	d.checkCRC8(what)
+	if d.err != nil {
+		return nil
+	} else if width > 64 {
+		d.fail(fmt.Errorf("invalid sequence width in %s: %d", what, width))
+		return nil
+	}
+	a := &logArray{width: width, n: n}
+	data := d.data(a.size())
+	d.checkCRC32(data, what)
+	if d.err != nil {
+		return nil
+	}
+	a.words = wordsOf(data)
+	// reserve a word to simplify reads at the end of the array
+	a.words = append(a.words, 0)
+	return a
+}
+
+func (d *decoder) bitmap(what string) *bitmap {
+	d.begin()
+	if typ := d.byte(); d.err == nil && typ != typeBitmap {
+		d.fail(fmt.Errorf("unsupported bitmap type in %s: %d", what, typ))
+	}
+	n := d.vbyte()
+	d.checkCRC8(what)
+	if d.err != nil {
+		return nil
+	}
+	b := &bitmap{n: n}
+	data := d.data(b.size())
+	d.checkCRC32(data, what)
+	if d.err != nil {
+		return nil
+	}
+	b.words = wordsOf(data)
+	if rest := n % 64; rest != 0 {
+		b.words[len(b.words)-1] &= 1<<rest - 1
+	}
+	b.index()
+	return b
+}
+
+func (d *decoder) section(what string) *section {
+	d.begin()
+	if typ := d.byte(); d.err == nil && typ != typePFC {
+		d.fail(fmt.Errorf("unsupported dictionary section type in %s: %d", what, typ))
+	}
+	s := &section{}
+	s.n = d.vbyte()
+	size := d.vbyte()
+	s.blockSize = d.vbyte()
+	d.checkCRC8(what)
+	if d.err == nil && s.blockSize == 0 {
+		d.fail(fmt.Errorf("invalid block size in %s", what))
+	}
+	s.blocks = d.logArray(what)
+	s.data = d.data(size)
+	d.checkCRC32(s.data, what)
+	if d.err != nil {
+		return nil
+	} else if s.blocks.n != (s.n+s.blockSize-1)/s.blockSize+1 {
+		d.fail(fmt.Errorf("invalid number of blocks in %s", what))
+		return nil
+	}
+	return s
+}
+
+// encoder writes HDT structures with their checksums.
+type encoder struct {
+	w   *bufio.Writer
+	err error
+}
+
+func newEncoder(w io.Writer) *encoder {
+	return &encoder{w: bufio.NewWriter(w)}
+}
+
+func (e *encoder) write(b []byte) {
+	if e.err != nil {
+		return
+	}
+	_, e.err = e.w.Write(b)
+}
+
+// header writes a header of a structure followed by its checksum.
+func (e *encoder) header(b []byte) {
+	e.write(append(b, crc8(b)))
+}
+
+// data writes the data of a structure followed by its checksum.
+func (e *encoder) data(b []byte) {
+	e.write(b)
+	e.write(binary.LittleEndian.AppendUint32(nil, crc32c(b)))
+}
+
+func (e *encoder) control(typ byte, format, props string) {
+	b := []byte(cookie)
+	b = append(b, typ)
+	b = append(b, format...)
+	b = append(b, 0)
+	b = append(b, props...)
+	b = append(b, 0)
+	b = binary.LittleEndian.AppendUint16(b, crc16(b))
+	e.write(b)
+}
+
+func (e *encoder) logArray(a *logArray) {
+	e.header(appendVByte([]byte{typeLogArray, a.width}, a.n))
+	e.data(bytesOf(a.words, a.size()))
+}
+
+func (e *encoder) bitmap(b *bitmap) {
+	e.header(appendVByte([]byte{typeBitmap}, b.n))
+	e.data(bytesOf(b.words, b.size()))
+}
+
+func (e *encoder) section(s *section) {
+	h := []byte{typePFC}
+	h = appendVByte(h, s.n)
+	h = appendVByte(h, uint64(len(s.data)))
+	h = appendVByte(h, s.blockSize)
+	e.header(h)
+	e.logArray(s.blocks)
+	e.data(s.data)
+}
+
+func (e *encoder) flush() error {
+	if e.err == nil {
+		e.err = e.w.Flush()
+	}
+	return e.err
+}
@@ -0,0 +1,269 @@
+package hdt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/nquads"
+)
+
+// Document is an HDT file loaded into memory.
+//
+// Terms are decoded only when they are returned, thus lookups are cheap even for large files.
+type Document struct {
+	header []byte
+	dict   dictionary
+	bp, bo *bitmap   // end of predicate list of each subject, end of object list of each pair
+	sp, so *logArray // predicate and object ids
+}
+
+// Open reads an HDT file.
+func Open(r io.Reader) (*Document, error) {
+	d := newDecoder(r)
+	doc := &Document{}
+	if c := d.control(typeGlobal, "global"); d.err == nil && c.format != formatHDT {
+		return nil, fmt.Errorf("unsupported HDT format: %s", c.format)
+	}
+	h := d.control(typeHeader, "header")
+	if d.err != nil {
+		return nil, d.err
+	} else if h.format != formatHeader {
+		return nil, fmt.Errorf("unsupported header format: %s", h.format)
+	}
+	n, err := strconv.ParseUint(h.props["length"], 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid header length: %q", h.props["length"])
+	}
+	doc.header = d.data(n)
+
+	if c := d.control(typeDictionary, "dictionary"); d.err == nil && c.format != formatDictionaryFour {
+		return nil, fmt.Errorf("unsupported dictionary format: %s", c.format)
+	}
+	doc.dict.shared = d.section("shared section")
+	doc.dict.subjects = d.section("subjects section")
+	doc.dict.predicates = d.section("predicates section")
+	doc.dict.objects = d.section("objects section")
+
+	c := d.control(typeTriples, "triples")
+	if d.err != nil {
+		return nil, d.err
+	} else if c.format != formatTriplesBitmap {
+		return nil, fmt.Errorf("unsupported triples format: %s", c.format)
+	} else if o := c.props["order"]; o != orderSPO {
+		return nil, fmt.Errorf("unsupported triples order: %s", o)
+	}
+	doc.bp = d.bitmap("predicate bitmap")
+	doc.bo = d.bitmap("object bitmap")
+	doc.sp = d.logArray("predicate sequence")
+	doc.so = d.logArray("object sequence")
+	if d.err != nil {
+		return nil, d.err
+	}
+	switch {
+	case doc.bp.n != doc.sp.n, doc.bo.n != doc.so.n, doc.bo.ones() != doc.sp.n:
+		return nil, fmt.Errorf("inconsistent triples index")
+	case doc.bp.ones() != doc.dict.shared.n+doc.dict.subjects.n:
+		return nil, fmt.Errorf("inconsistent number of subjects")
+	}
+	return doc, nil
+}
+
+// Len returns the number of triples in the document.
+func (d *Document) Len() int {
+	return int(d.so.n)
+}
+
+// Header returns a reader for the metadata of the dataset.
+func (d *Document) Header() quad.ReadCloser {
+	return nquads.NewReader(bytes.NewReader(d.header), false)
+}
+
+// Search returns triples that match the pattern. Nil values match any term.
+//
+// Lookups with a bound subject are answered directly from the index. Other patterns
+// scan the index, but decode only the terms of matching triples.
+func (d *Document) Search(s, p, o quad.Value) *Reader {
+	r := &Reader{doc: d, subEnd: d.bp.ones()}
+	if s != nil {
+		str, err := termOf(s)
+		if err != nil {
+			return &Reader{err: err}
+		}
+		id, err := d.dict.subjectID(str)
+		if err != nil {
+			return &Reader{err: err}
+		}
+		if id == 0 {
+			r.subEnd = 0
+		} else {
+			r.sub, r.subEnd = id-1, id
+		}
+	}
+	if p != nil {
+		str, err := termOf(p)
+		if err != nil {
+			return &Reader{err: err}
+		}
+		if r.p, err = d.dict.predicateID(str); err != nil {
+			return &Reader{err: err}
+		} else if r.p == 0 {
+			r.subEnd = 0
+		}
+	}
+	if o != nil {
+		str, err := termOf(o)
+		if err != nil {
+			return &Reader{err: err}
+		}
+		if r.o, err = d.dict.objectID(str); err != nil {
+			return &Reader{err: err}
+		} else if r.o == 0 {
+			r.subEnd = 0
+		}
+	}
+	return r
+}
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+// Reader iterates over triples of HDT document.
+type Reader struct {
+	doc *Document
+	err error
+
+	p, o uint64 // bound ids, or 0
+
+	sub, subEnd uint64 // current and last subject id
+	pi, pEnd    uint64 // positions of predicates of the current subject
+	oi, oEnd    uint64 // positions of objects of the current pair
+
+	pid    uint64     // current predicate id
+	sv, pv quad.Value // current subject and predicate, if decoded
+}
+
+// NewReader reads an HDT file and returns a reader for all triples in it.
+//
+// The whole file is loaded into memory. Use Open to run multiple lookups on the same file.
+func NewReader(r io.Reader) *Reader {
+	doc, err := Open(r)
+	if err != nil {
+		return &Reader{err: err}
+	}
+	return doc.Search(nil, nil, nil)
+}
+
+// ReadQuad implements quad.Reader.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	if r.err != nil {
+		return quad.Quad{}, r.err
+	}
+	d := r.doc
+	for {
+		if r.oi < r.oEnd {
+			oid := d.so.get(r.oi)
+			r.oi++
+			if r.o != 0 && oid != r.o {
+				continue
+			}
+			var q quad.Quad
+			if q, r.err = r.triple(oid); r.err != nil {
+				return quad.Quad{}, r.err
+			}
+			return q, nil
+		} else if r.pi < r.pEnd {
+			j := r.pi
+			r.pi++
+			pid := d.sp.get(j)
+			if r.p != 0 && pid != r.p {
+				continue
+			}
+			if r.oi, r.oEnd, r.err = r.objects(j); r.err != nil {
+				return quad.Quad{}, r.err
+			}
+			r.pid, r.pv = pid, nil
+			continue
+		} else if r.sub >= r.subEnd {
+			r.err = io.EOF
+			return quad.Quad{}, r.err
+		}
+		r.sub++
+		r.sv = nil
+		if r.pi, r.pEnd, r.err = r.predicates(r.sub); r.err != nil {
+			return quad.Quad{}, r.err
+		}
+	}
+}
+
+// triple decodes a triple with a given object. Subject and predicate are decoded only once.
+func (r *Reader) triple(oid uint64) (quad.Quad, error) {
+	d := r.doc
+	if r.sv == nil {
+		str, err := d.dict.subject(r.sub)
+		if err != nil {
+			return quad.Quad{}, err
+		} else if r.sv, err = valueOf(str); err != nil {
+			return quad.Quad{}, err
+		}
+	}
+	if r.pv == nil {
+		str, err := d.dict.predicate(r.pid)
+		if err != nil {
+			return quad.Quad{}, err
+		} else if r.pv, err = valueOf(str); err != nil {
+			return quad.Quad{}, err
+		}
+	}
+	str, err := d.dict.object(oid)
+	if err != nil {
+		return quad.Quad{}, err
+	}
+	o, err := valueOf(str)
+	if err != nil {
+		return quad.Quad{}, err
+	}
+	return quad.Quad{Subject: r.sv, Predicate: r.pv, Object: o}, nil
+}
+
+// predicates returns the range of positions of predicates of a subject.
+func (r *Reader) predicates(sub uint64) (start, end uint64, err error) {
+	if sub > 1 {
+		if start, err = r.doc.bp.select1(sub - 1); err != nil {
+			return
+		}
+		start++
+	}
+	end, err = r.doc.bp.select1(sub)
+	return start, end + 1, err
+}
+
+// objects returns the range of positions of objects for a predicate at a given position.
+func (r *Reader) objects(j uint64) (start, end uint64, err error) {
+	if j > 0 {
+		if start, err = r.doc.bo.select1(j); err != nil {
+			return
+		}
+		start++
+	}
+	end, err = r.doc.bo.select1(j + 1)
+	return start, end + 1, err
+}
+
+// ReadQuads implements quad.BatchReader.
+func (r *Reader) ReadQuads(buf []quad.Quad) (int, error) {
+	for i := range buf {
+		q, err := r.ReadQuad()
+		if err != nil {
+			return i, err
+		}
+		buf[i] = q
+	}
+	return len(buf), nil
+}
+
+// Close implements quad.Reader.
+func (r *Reader) Close() error {
+	return nil
+}
@@ -0,0 +1,227 @@
+//go:build ignore
+
+// This program writes example.hdt, following the HDT specification and the layout of files
+// produced by hdt-cpp (rdf2hdt). It doesn't use the hdt package, so the file can be used to check
+// the decoder independently of the encoder.
+//
+// The file contains the following triples:
+//
+//	<http://example.org/alice> <http://xmlns.com/foaf/0.1/knows> <http://example.org/bob> .
+//	<http://example.org/alice> <http://xmlns.com/foaf/0.1/name> "Alice" .
+//	<http://example.org/bob> <http://xmlns.com/foaf/0.1/knows> _:b1 .
+//	<http://example.org/bob> <http://xmlns.com/foaf/0.1/name> "Bob"@en .
+//	_:b1 <http://xmlns.com/foaf/0.1/age> "42"^^<http://www.w3.org/2001/XMLSchema#integer> .
+//
+// Run it with "go run gen.go" in this directory.
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"log"
+	"os"
+	"strconv"
+)
+
+const header = `<http://example.org/dataset> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://purl.org/HDT/hdt#Dataset> .
+<http://example.org/dataset> <http://www.w3.org/1999/02/22-rdf-syntax-ns#type> <http://rdfs.org/ns/void#Dataset> .
+<http://example.org/dataset> <http://rdfs.org/ns/void#triples> "5" .
+<http://example.org/dataset> <http://rdfs.org/ns/void#properties> "3" .
+<http://example.org/dataset> <http://rdfs.org/ns/void#distinctSubjects> "3" .
+<http://example.org/dataset> <http://rdfs.org/ns/void#distinctObjects> "5" .
+`
+
+// Dictionary sections, sorted by bytes. Ids start from 1, and ids of subject-only
+// and object-only terms follow the ids of the shared terms.
+var (
+	// 1, 2
+	shared = []string{"_:b1", "http://example.org/bob"}
+	// 3
+	subjects = []string{"http://example.org/alice"}
+	// 1, 2, 3
+	predicates = []string{
+		"http://xmlns.com/foaf/0.1/age",
+		"http://xmlns.com/foaf/0.1/knows",
+		"http://xmlns.com/foaf/0.1/name",
+	}
+	// 3, 4, 5
+	objects = []string{
+		`"42"^^<http://www.w3.org/2001/XMLSchema#integer>`,
+		`"Alice"`,
+		`"Bob"@en`,
+	}
+)
+
+// Triples in SPO order: subject 1 has predicate 1, subjects 2 and 3 have predicates 2 and 3,
+// and every pair has a single object.
+var (
+	seqY    = []uint64{1, 2, 3, 2, 3}
+	bitmapY = []bool{true, false, true, false, true}
+	seqZ    = []uint64{3, 1, 5, 2, 4}
+	bitmapZ = []bool{true, true, true, true, true}
+)
+
+var out bytes.Buffer
+
+// CRC-8-CCITT, polynomial 0x07.
+func crc8(b []byte) byte {
+	var crc byte
+	for _, c := range b {
+		crc ^= c
+		for i := 0; i < 8; i++ {
+			if crc&0x80 != 0 {
+				crc = crc<<1 ^ 0x07
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// CRC-16-ANSI, reflected polynomial 0xA001.
+func crc16(b []byte) uint16 {
+	var crc uint16
+	for _, c := range b {
+		crc ^= uint16(c)
+		for i := 0; i < 8; i++ {
+			if crc&1 != 0 {
+				crc = crc>>1 ^ 0xa001
+			} else {
+				crc >>= 1
+			}
+		}
+	}
+	return crc
+}
+
+func crc32c(b []byte) uint32 {
+	return crc32.Checksum(b, crc32.MakeTable(crc32.Castagnoli))
+}
+
+// vbyte encodes 7 bits per byte, least significant first, with the high bit set on the last byte.
+func vbyte(b []byte, v uint64) []byte {
+	for v > 0x7f {
+		b = append(b, byte(v&0x7f))
+		v >>= 7
+	}
+	return append(b, byte(v)|0x80)
+}
+
+func control(typ byte, format, props string) {
+	b := append([]byte("$HDT"), typ)
+	b = append(append(b, format...), 0)
+	b = append(append(b, props...), 0)
+	out.Write(binary.LittleEndian.AppendUint16(b, crc16(b)))
+}
+
+// preamble writes a header of a structure followed by CRC8.
+func preamble(b []byte) {
+	out.Write(append(b, crc8(b)))
+}
+
+// data writes the data of a structure followed by CRC32C.
+func data(b []byte) {
+	out.Write(binary.LittleEndian.AppendUint32(b, crc32c(b)))
+}
+
+func width(max uint64) byte {
+	var n byte
+	for ; max != 0; max >>= 1 {
+		n++
+	}
+	return n
+}
+
+// logSequence writes a LogSequence2 with values packed from the lowest bit of the first byte.
+func logSequence(vals []uint64) {
+	var max uint64
+	for _, v := range vals {
+		if v > max {
+			max = v
+		}
+	}
+	w := width(max)
+	preamble(vbyte([]byte{1, w}, uint64(len(vals))))
+	b := make([]byte, (int(w)*len(vals)+7)/8)
+	for i, v := range vals {
+		for j := 0; j < int(w); j++ {
+			if v&(1<<j) != 0 {
+				bit := i*int(w) + j
+				b[bit/8] |= 1 << (bit % 8)
+			}
+		}
+	}
+	data(b)
+}
+
+// bitmap writes a BitSequence375 with bits packed from the lowest bit of the first byte.
+func bitmap(bits []bool) {
+	preamble(vbyte([]byte{1}, uint64(len(bits))))
+	b := make([]byte, (len(bits)+7)/8)
+	for i, v := range bits {
+		if v {
+			b[i/8] |= 1 << (i % 8)
+		}
+	}
+	data(b)
+}
+
+// section writes a plain front coding dictionary section with the default block size of hdt-cpp.
+func section(strs []string) {
+	const blockSize = 16
+	var (
+		b    []byte
+		offs []uint64
+	)
+	for i, s := range strs {
+		if i%blockSize == 0 {
+			offs = append(offs, uint64(len(b)))
+			b = append(b, s...)
+		} else {
+			prev, n := strs[i-1], 0
+			for n < len(s) && n < len(prev) && s[n] == prev[n] {
+				n++
+			}
+			b = append(vbyte(b, uint64(n)), s[n:]...)
+		}
+		b = append(b, 0)
+	}
+	offs = append(offs, uint64(len(b)))
+	h := vbyte([]byte{2}, uint64(len(strs)))
+	h = vbyte(h, uint64(len(b)))
+	h = vbyte(h, blockSize)
+	preamble(h)
+	logSequence(offs)
+	data(b)
+}
+
+func main() {
+	control(1, "<http://purl.org/HDT/hdt#HDTv1>", "")
+
+	control(2, "ntriples", "length="+strconv.Itoa(len(header))+";")
+	out.WriteString(header)
+
+	size := 0
+	for _, list := range [][]string{shared, subjects, predicates, objects} {
+		for _, s := range list {
+			size += len(s) + 1
+		}
+	}
+	control(3, "<http://purl.org/HDT/hdt#dictionaryFour>", "mapping=1;sizeStrings="+strconv.Itoa(size)+";")
+	section(shared)
+	section(subjects)
+	section(predicates)
+	section(objects)
+
+	control(4, "<http://purl.org/HDT/hdt#triplesBitmap>", "order=1;numTriples=5;")
+	bitmap(bitmapY)
+	bitmap(bitmapZ)
+	logSequence(seqY)
+	logSequence(seqZ)
+
+	if err := os.WriteFile("example.hdt", out.Bytes(), 0644); err != nil {
+		log.Fatal(err)
+	}
+}
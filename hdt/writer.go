@@ -0,0 +1,266 @@
+package hdt
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/nquads"
+	"github.com/cayleygraph/quad/voc/rdf"
+)
+
+// DefaultBlockSize is a default number of strings in a block of a dictionary section.
+const DefaultBlockSize = 16
+
+// DefaultBaseIRI is a default IRI of the dataset described in the header.
+const DefaultBaseIRI = quad.IRI("urn:hdt:dataset")
+
+const (
+	nsVoid = "http://rdfs.org/ns/void#"
+	nsHDT  = "http://purl.org/HDT/hdt#"
+)
+
+// Options for the HDT encoder.
+type Options struct {
+	// BaseIRI is an IRI of the dataset described in the header.
+	BaseIRI quad.IRI
+	// BlockSize is a number of strings in a block of a dictionary section.
+	// Larger blocks give a better compression, but make lookups slower.
+	BlockSize int
+}
+
+// Subject and object roles of terms.
+const (
+	roleSubject = 1 << iota
+	roleObject
+)
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// Writer builds an HDT file from a set of triples.
+//
+// Since the dictionary must be complete before the triples index is written, all triples are
+// kept in memory and the file is written when the Writer is closed. The input doesn't need
+// to be sorted and duplicate triples are removed.
+type Writer struct {
+	w    io.Writer
+	opts Options
+	err  error
+
+	roles   map[string]int
+	preds   map[string]struct{}
+	triples [][3]string
+}
+
+// NewWriter creates HDT encoder. Options can be nil.
+func NewWriter(w io.Writer, opts *Options) *Writer {
+	if opts == nil {
+		opts = &Options{}
+	}
+	o := *opts
+	if o.BaseIRI == "" {
+		o.BaseIRI = DefaultBaseIRI
+	}
+	if o.BlockSize <= 0 {
+		o.BlockSize = DefaultBlockSize
+	}
+	return &Writer{
+		w: w, opts: o,
+		roles: make(map[string]int),
+		preds: make(map[string]struct{}),
+	}
+}
+
+// WriteQuad implements quad.Writer. Quads with a label are not supported.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	} else if q.Label != nil {
+		return fmt.Errorf("named graphs are not supported")
+	}
+	var t [3]string
+	for i, v := range []quad.Value{q.Subject, q.Predicate, q.Object} {
+		s, err := termOf(v)
+		if err != nil {
+			return err
+		}
+		t[i] = s
+	}
+	if t[0][0] == '"' {
+		return fmt.Errorf("unsupported subject value: %v", q.Subject)
+	}
+	w.roles[t[0]] |= roleSubject
+	w.preds[t[1]] = struct{}{}
+	w.roles[t[2]] |= roleObject
+	w.triples = append(w.triples, t)
+	return nil
+}
+
+// WriteQuads implements quad.BatchWriter.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+// dictionary builds dictionary sections and assigns ids to terms.
+func (w *Writer) dictionary() (d dictionary, subs, preds, objs map[string]uint64) {
+	var shared, subjects, objects, predicates []string
+	for s, r := range w.roles {
+		switch r {
+		case roleSubject | roleObject:
+			shared = append(shared, s)
+		case roleSubject:
+			subjects = append(subjects, s)
+		case roleObject:
+			objects = append(objects, s)
+		}
+	}
+	for s := range w.preds {
+		predicates = append(predicates, s)
+	}
+	for _, list := range [][]string{shared, subjects, objects, predicates} {
+		sort.Strings(list)
+	}
+	subs, preds, objs = make(map[string]uint64), make(map[string]uint64), make(map[string]uint64)
+	for i, s := range shared {
+		subs[s], objs[s] = uint64(i+1), uint64(i+1)
+	}
+	for i, s := range subjects {
+		subs[s] = uint64(len(shared) + i + 1)
+	}
+	for i, s := range objects {
+		objs[s] = uint64(len(shared) + i + 1)
+	}
+	for i, s := range predicates {
+		preds[s] = uint64(i + 1)
+	}
+	bs := uint64(w.opts.BlockSize)
+	d = dictionary{
+		shared:     newSection(shared, bs),
+		subjects:   newSection(subjects, bs),
+		predicates: newSection(predicates, bs),
+		objects:    newSection(objects, bs),
+	}
+	return
+}
+
+// index builds the bitmap triples index for triples sorted by subject, predicate and object.
+func index(ts [][3]uint64, maxP, maxO uint64) (bp, bo *bitmap, sp, so *logArray) {
+	var sps, sos []uint64
+	var ends, oends []uint64
+	for i, t := range ts {
+		newS := i == 0 || t[0] != ts[i-1][0]
+		if newS || t[1] != ts[i-1][1] {
+			if i > 0 {
+				oends = append(oends, uint64(len(sos)-1))
+				if newS {
+					ends = append(ends, uint64(len(sps)-1))
+				}
+			}
+			sps = append(sps, t[1])
+		}
+		sos = append(sos, t[2])
+	}
+	if len(ts) != 0 {
+		oends = append(oends, uint64(len(sos)-1))
+		ends = append(ends, uint64(len(sps)-1))
+	}
+	bp, bo = newBitmap(uint64(len(sps))), newBitmap(uint64(len(sos)))
+	for _, i := range ends {
+		bp.set(i)
+	}
+	for _, i := range oends {
+		bo.set(i)
+	}
+	sp, so = newLogArray(bitsFor(maxP), uint64(len(sps))), newLogArray(bitsFor(maxO), uint64(len(sos)))
+	for i, v := range sps {
+		sp.set(uint64(i), v)
+	}
+	for i, v := range sos {
+		so.set(uint64(i), v)
+	}
+	return
+}
+
+// header generates the metadata of the dataset.
+func (w *Writer) header(triples, subjects, predicates, objects int) ([]byte, error) {
+	base := w.opts.BaseIRI
+	buf := bytes.NewBuffer(nil)
+	hw := nquads.NewWriter(buf)
+	_, err := hw.WriteQuads([]quad.Quad{
+		{Subject: base, Predicate: quad.IRI(rdf.Type).Full(), Object: quad.IRI(nsHDT + "Dataset")},
+		{Subject: base, Predicate: quad.IRI(rdf.Type).Full(), Object: quad.IRI(nsVoid + "Dataset")},
+		{Subject: base, Predicate: quad.IRI(nsVoid + "triples"), Object: quad.String(strconv.Itoa(triples))},
+		{Subject: base, Predicate: quad.IRI(nsVoid + "properties"), Object: quad.String(strconv.Itoa(predicates))},
+		{Subject: base, Predicate: quad.IRI(nsVoid + "distinctSubjects"), Object: quad.String(strconv.Itoa(subjects))},
+		{Subject: base, Predicate: quad.IRI(nsVoid + "distinctObjects"), Object: quad.String(strconv.Itoa(objects))},
+	})
+	if err != nil {
+		return nil, err
+	} else if err = hw.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// Close writes HDT file.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	dict, subs, preds, objs := w.dictionary()
+	ts := make([][3]uint64, 0, len(w.triples))
+	for _, t := range w.triples {
+		ts = append(ts, [3]uint64{subs[t[0]], preds[t[1]], objs[t[2]]})
+	}
+	w.triples, w.roles, w.preds = nil, nil, nil
+	sort.Slice(ts, func(i, j int) bool {
+		a, b := ts[i], ts[j]
+		if a[0] != b[0] {
+			return a[0] < b[0]
+		} else if a[1] != b[1] {
+			return a[1] < b[1]
+		}
+		return a[2] < b[2]
+	})
+	uniq := ts[:0]
+	for i, t := range ts {
+		if i == 0 || t != ts[i-1] {
+			uniq = append(uniq, t)
+		}
+	}
+	ts = uniq
+	bp, bo, sp, so := index(ts, dict.predicates.n, dict.shared.n+dict.objects.n)
+
+	header, err := w.header(len(ts), int(dict.shared.n+dict.subjects.n), int(dict.predicates.n), int(dict.shared.n+dict.objects.n))
+	if err != nil {
+		return err
+	}
+	e := newEncoder(w.w)
+	e.control(typeGlobal, formatHDT, "")
+	e.control(typeHeader, formatHeader, "length="+strconv.Itoa(len(header))+";")
+	e.write(header)
+
+	size := len(dict.shared.data) + len(dict.subjects.data) + len(dict.predicates.data) + len(dict.objects.data)
+	e.control(typeDictionary, formatDictionaryFour, "mapping=1;sizeStrings="+strconv.Itoa(size)+";")
+	for _, s := range []*section{dict.shared, dict.subjects, dict.predicates, dict.objects} {
+		e.section(s)
+	}
+
+	e.control(typeTriples, formatTriplesBitmap, "order="+orderSPO+";")
+	e.bitmap(bp)
+	e.bitmap(bo)
+	e.logArray(sp)
+	e.logArray(so)
+	return e.flush()
+}
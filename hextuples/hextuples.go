@@ -0,0 +1,289 @@
+// Package hextuples provides an encoder/decoder for HexTuples NDJSON format.
+//
+// Each line of the document is a JSON array of six strings: subject, predicate, value, datatype, language and graph.
+//
+// See https://github.com/ontola/hextuples for the format definition.
+package hextuples
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/voc/rdf"
+	"github.com/cayleygraph/quad/voc/xsd"
+)
+
+// AutoConvertTypedString allows to convert TypedString values to native
+// equivalents directly while parsing. It will call ToNative on all TypedString values.
+//
+// If conversion error occurs, it will preserve original TypedString value.
+var AutoConvertTypedString = true
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "hextuples",
+		Ext:    []string{".hext"},
+		Mime:   []string{"application/hex+x-ndjson"},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r) },
+		Sniff:  sniff,
+	})
+}
+
+// sniff checks that the first line of the stream is an array of six strings.
+func sniff(head []byte) bool {
+	for len(head) != 0 {
+		line := head
+		if i := bytes.IndexByte(head, '\n'); i >= 0 {
+			line, head = head[:i], head[i+1:]
+		} else {
+			head = nil
+		}
+		if line = bytes.TrimSpace(line); len(line) == 0 {
+			continue
+		}
+		var row []string
+		return json.Unmarshal(line, &row) == nil && len(row) == 6
+	}
+	return false
+}
+
+const (
+	// GlobalID is a datatype used for IRI values.
+	GlobalID = "globalId"
+	// LocalID is a datatype used for blank node values.
+	LocalID = "localId"
+)
+
+var (
+	xsdString     = quad.IRI(xsd.String).Full()
+	xsdBoolean    = quad.IRI(xsd.Boolean).Full()
+	xsdDateTime   = quad.IRI(xsd.DateTime).Full()
+	rdfLangString = quad.IRI(rdf.LangString).Full()
+)
+
+// term encodes a subject, predicate or graph value.
+func term(v quad.Value) (string, bool) {
+	switch v := v.(type) {
+	case quad.IRI:
+		return string(v), true
+	case quad.BNode:
+		return v.String(), true
+	}
+	return "", false
+}
+
+// parseTerm decodes a subject, predicate or graph value.
+func parseTerm(s string) quad.Value {
+	if strings.HasPrefix(s, "_:") {
+		return quad.BNode(s[2:])
+	}
+	return quad.IRI(s)
+}
+
+// object encodes an object value as a value, datatype and language.
+func object(v quad.Value) (val, dt, lang string, ok bool) {
+	switch v := v.(type) {
+	case quad.IRI:
+		return string(v), GlobalID, "", true
+	case quad.BNode:
+		return v.String(), LocalID, "", true
+	case quad.String:
+		return string(v), string(xsdString), "", true
+	case quad.LangString:
+		return string(v.Value), string(rdfLangString), v.Lang, true
+	case quad.TypedString:
+		return string(v.Value), string(v.Type.Full()), "", true
+	case quad.Bool:
+		// use the canonical lexical form of xsd:boolean
+		return strconv.FormatBool(bool(v)), string(xsdBoolean), "", true
+	case quad.Time:
+		// preserve nanoseconds and the offset, unlike TypedString
+		return time.Time(v).Format(time.RFC3339Nano), string(xsdDateTime), "", true
+	case quad.TypedStringer:
+		ts := v.TypedString()
+		return string(ts.Value), string(ts.Type.Full()), "", true
+	}
+	return "", "", "", false
+}
+
+// parseObject decodes an object value from a value, datatype and language.
+func parseObject(val, dt, lang string) quad.Value {
+	switch {
+	case dt == GlobalID:
+		return quad.IRI(val)
+	case dt == LocalID:
+		return quad.BNode(strings.TrimPrefix(val, "_:"))
+	case lang != "" || quad.IRI(dt) == rdfLangString:
+		return quad.LangString{Value: quad.String(val), Lang: lang}
+	case dt == "" || quad.IRI(dt) == xsdString:
+		return quad.String(val)
+	}
+	v := quad.TypedString{Value: quad.String(val), Type: quad.IRI(dt)}
+	if AutoConvertTypedString {
+		if nv, err := v.ParseValue(); err == nil {
+			return nv
+		}
+	}
+	return v
+}
+
+var (
+	_ quad.ReadCloser  = (*Reader)(nil)
+	_ quad.BatchReader = (*Reader)(nil)
+)
+
+// Reader implements HexTuples document parsing. Empty lines are skipped.
+type Reader struct {
+	r    *bufio.Reader
+	line int
+	err  error
+}
+
+// NewReader returns a HexTuples decoder that takes its input from the provided io.Reader.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{r: bufio.NewReader(r)}
+}
+
+func (r *Reader) readQuad() (quad.Quad, error) {
+	for {
+		line, err := r.r.ReadBytes('\n')
+		if err == io.EOF && len(line) != 0 {
+			err = nil
+		} else if err != nil {
+			return quad.Quad{}, err
+		}
+		r.line++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var row []string
+		if err := json.Unmarshal(line, &row); err != nil {
+			return quad.Quad{}, fmt.Errorf("line %d: %w", r.line, err)
+		} else if len(row) != 6 {
+			return quad.Quad{}, fmt.Errorf("line %d: expected 6 elements, got %d", r.line, len(row))
+		}
+		q := quad.Quad{
+			Subject:   parseTerm(row[0]),
+			Predicate: parseTerm(row[1]),
+			Object:    parseObject(row[2], row[3], row[4]),
+		}
+		if row[5] != "" {
+			q.Label = parseTerm(row[5])
+		}
+		if !q.IsValid() {
+			return quad.Quad{}, fmt.Errorf("line %d: %w", r.line, quad.ErrInvalid)
+		}
+		return q, nil
+	}
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	if r.err != nil {
+		return quad.Quad{}, r.err
+	}
+	q, err := r.readQuad()
+	r.err = err
+	return q, err
+}
+
+// ReadQuads implements quad.BatchReader.
+//
+// If an error occurs after some quads were read, they are returned first
+// and the error is returned on the next call.
+func (r *Reader) ReadQuads(buf []quad.Quad) (int, error) {
+	for i := range buf {
+		q, err := r.ReadQuad()
+		if err != nil {
+			if i != 0 {
+				return i, nil
+			}
+			return 0, err
+		}
+		buf[i] = q
+	}
+	return len(buf), nil
+}
+
+// Close implements quad.ReadCloser.
+func (r *Reader) Close() error { return nil }
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// Writer implements HexTuples document generator.
+//
+// IRIs are written with the globalId datatype and blank nodes with the localId datatype.
+// Native values are written with their full datatype IRIs.
+type Writer struct {
+	w   *bufio.Writer
+	enc *json.Encoder
+	err error
+}
+
+// NewWriter returns a HexTuples encoder that writes its output to the provided io.Writer.
+func NewWriter(w io.Writer) *Writer {
+	bw := bufio.NewWriter(w)
+	enc := json.NewEncoder(bw)
+	enc.SetEscapeHTML(false)
+	return &Writer{w: bw, enc: enc}
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	}
+	s, ok := term(q.Subject)
+	if !ok {
+		return fmt.Errorf("unsupported subject value: %v", q.Subject)
+	}
+	p, ok := term(q.Predicate)
+	if !ok {
+		return fmt.Errorf("unsupported predicate value: %v", q.Predicate)
+	}
+	val, dt, lang, ok := object(q.Object)
+	if !ok {
+		return fmt.Errorf("unsupported object value: %v", q.Object)
+	}
+	var g string
+	if q.Label != nil {
+		if g, ok = term(q.Label); !ok {
+			return fmt.Errorf("unsupported label value: %v", q.Label)
+		}
+	}
+	w.err = w.enc.Encode([6]string{s, p, val, dt, lang, g})
+	return w.err
+}
+
+// WriteQuads implements quad.BatchWriter.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+// Close flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if w.err = w.w.Flush(); w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	return nil
+}
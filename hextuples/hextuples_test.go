@@ -0,0 +1,101 @@
+package hextuples_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/hextuples"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+var testQuads = []quad.Quad{
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob?a=1&b=<2>")},
+	{Subject: iri("alice"), Predicate: iri("friend"), Object: quad.BNode("carol")},
+	{Subject: quad.BNode("carol"), Predicate: iri("name"), Object: quad.String("Carol \"C\"\n")},
+	{Subject: quad.BNode("carol"), Predicate: iri("title"), Object: quad.LangString{Value: "Dr", Lang: "en"}, Label: iri("g")},
+	{Subject: quad.BNode("carol"), Predicate: iri("born"), Object: quad.TypedString{Value: "1990-07", Type: "http://www.w3.org/2001/XMLSchema#gYearMonth"}, Label: quad.BNode("g2")},
+	{Subject: quad.BNode("carol"), Predicate: iri("age"), Object: quad.Int(42)},
+	{Subject: quad.BNode("carol"), Predicate: iri("height"), Object: quad.Float(1.75)},
+	{Subject: quad.BNode("carol"), Predicate: iri("active"), Object: quad.Bool(true)},
+	{Subject: quad.BNode("carol"), Predicate: iri("seen"), Object: quad.Time(time.Date(2020, 1, 2, 3, 4, 5, 678, time.UTC))},
+	{Subject: quad.BNode("carol"), Predicate: iri("seen"), Object: quad.Time(time.Date(2021, 6, 7, 8, 9, 10, 0, time.FixedZone("", 3*60*60)))},
+}
+
+const testData = `["http://example.org/alice","http://example.org/knows","http://example.org/bob?a=1&b=<2>","globalId","",""]
+["http://example.org/alice","http://example.org/friend","_:carol","localId","",""]
+["_:carol","http://example.org/name","Carol \"C\"\n","http://www.w3.org/2001/XMLSchema#string","",""]
+["_:carol","http://example.org/title","Dr","http://www.w3.org/1999/02/22-rdf-syntax-ns#langString","en","http://example.org/g"]
+["_:carol","http://example.org/born","1990-07","http://www.w3.org/2001/XMLSchema#gYearMonth","","_:g2"]
+["_:carol","http://example.org/age","42","http://www.w3.org/2001/XMLSchema#integer","",""]
+["_:carol","http://example.org/height","1.75E+00","http://www.w3.org/2001/XMLSchema#double","",""]
+["_:carol","http://example.org/active","true","http://www.w3.org/2001/XMLSchema#boolean","",""]
+["_:carol","http://example.org/seen","2020-01-02T03:04:05.000000678Z","http://www.w3.org/2001/XMLSchema#dateTime","",""]
+["_:carol","http://example.org/seen","2021-06-07T08:09:10+03:00","http://www.w3.org/2001/XMLSchema#dateTime","",""]
+`
+
+func TestWriter(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	w := hextuples.NewWriter(buf)
+	_, err := quad.Copy(w, quad.NewReader(testQuads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, testData, buf.String())
+}
+
+func TestReader(t *testing.T) {
+	r := hextuples.NewReader(strings.NewReader(testData + "\n"))
+	defer r.Close()
+	quads, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, testQuads, quads)
+}
+
+func TestCopyBatch(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	w := hextuples.NewWriter(buf)
+	n, err := quad.CopyBatch(w, quad.NewReader(testQuads), 2)
+	require.NoError(t, err)
+	require.Equal(t, len(testQuads), n)
+	require.NoError(t, w.Close())
+
+	var out quad.Quads
+	n, err = quad.CopyBatch(&batchWriter{&out}, hextuples.NewReader(buf), 4)
+	require.NoError(t, err)
+	require.Equal(t, len(testQuads), n)
+	got, err := quad.ReadAll(&out)
+	require.NoError(t, err)
+	require.Equal(t, testQuads, got)
+}
+
+type batchWriter struct {
+	*quad.Quads
+}
+
+func (w *batchWriter) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+func TestReaderErrors(t *testing.T) {
+	_, err := quad.ReadAll(hextuples.NewReader(strings.NewReader(testData + `["a","b","c"]`)))
+	require.EqualError(t, err, `line 11: expected 6 elements, got 3`)
+
+	_, err = quad.ReadAll(hextuples.NewReader(strings.NewReader(`["a","b","c","globalId","",1]`)))
+	require.Error(t, err)
+}
+
+func TestWriterUnsupported(t *testing.T) {
+	w := hextuples.NewWriter(&bytes.Buffer{})
+	err := w.WriteQuad(quad.Quad{Subject: quad.String("a"), Predicate: iri("p"), Object: quad.String("b")})
+	require.EqualError(t, err, `unsupported subject value: "a"`)
+}
@@ -0,0 +1,30 @@
+// Package bnode generates blank nodes for decoders.
+package bnode
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cayleygraph/quad"
+)
+
+// Generator generates blank nodes that don't collide with labels used in a document.
+//
+// Unlike quad.Sequence, each generator prefixes labels with a random string, for example "g1f2e3d4c_n1".
+// It's safe for concurrent use. Zero value is ready to use.
+type Generator struct {
+	once   sync.Once
+	prefix string
+	last   uint64
+}
+
+// Next returns a new blank node.
+func (g *Generator) Next() quad.BNode {
+	g.once.Do(func() {
+		g.prefix = fmt.Sprintf("g%08x_", rand.Uint32())
+	})
+	n := atomic.AddUint64(&g.last, 1)
+	return quad.BNode(fmt.Sprintf("%sn%d", g.prefix, n))
+}
@@ -0,0 +1,70 @@
+// Package iritemplate implements IRI templates used by mappings of tabular and tree data to quads.
+package iritemplate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+)
+
+// Template is a parsed IRI template, for example "http://example.org/person/{id}".
+type Template struct {
+	// Exprs are expressions in braces, in order of appearance.
+	Exprs []string
+	lits  []string // literal parts around expressions
+}
+
+// Parse splits a template into literal parts and expressions.
+func Parse(s string) (*Template, error) {
+	t := &Template{}
+	for {
+		i := strings.IndexByte(s, '{')
+		if i < 0 {
+			t.lits = append(t.lits, s)
+			return t, nil
+		}
+		j := strings.IndexByte(s[i:], '}')
+		if j < 0 {
+			return nil, fmt.Errorf("unterminated template expression: %q", s[i:])
+		}
+		t.lits = append(t.lits, s[:i])
+		t.Exprs = append(t.Exprs, s[i+1:i+j])
+		s = s[i+j+1:]
+	}
+}
+
+// Expand builds an IRI, replacing each expression with a percent-encoded value returned by the function
+// for the expression index. Errors of the function are returned as is.
+func (t *Template) Expand(value func(i int) (string, error)) (quad.IRI, error) {
+	var sb strings.Builder
+	for i := range t.Exprs {
+		sb.WriteString(t.lits[i])
+		s, err := value(i)
+		if err != nil {
+			return "", err
+		}
+		sb.WriteString(Escape(s))
+	}
+	sb.WriteString(t.lits[len(t.lits)-1])
+	return quad.IRI(sb.String()), nil
+}
+
+// Escape percent-encodes all characters except unreserved ones, as in RFC 6570 simple expansion.
+func Escape(s string) string {
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if c := s[i]; isUnreserved(c) {
+			sb.WriteByte(c)
+		} else {
+			fmt.Fprintf(&sb, "%%%02X", c)
+		}
+	}
+	return sb.String()
+}
+
+// isUnreserved checks if a byte is an unreserved URI character.
+func isUnreserved(c byte) bool {
+	return (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9') ||
+		c == '-' || c == '.' || c == '_' || c == '~'
+}
@@ -0,0 +1,21 @@
+// Package pgraph provides helpers for encoders of property graph formats.
+package pgraph
+
+import "github.com/cayleygraph/quad"
+
+// NodeID returns a stable ID for a value: a full IRI for IRIs, and N-Quads notation for other values.
+func NodeID(v quad.Value) string {
+	if iri, ok := v.(quad.IRI); ok {
+		return string(iri.Full())
+	}
+	return v.String()
+}
+
+// IsNode checks if a value is written as a node rather than a property.
+func IsNode(v quad.Value) bool {
+	switch v.(type) {
+	case quad.IRI, quad.BNode:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,34 @@
+// Package term parses single values in N-Quads syntax, as stored in cells and labels of other formats.
+package term
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/nquads"
+)
+
+// Parse parses an IRI, a blank node or a literal in N-Quads syntax.
+//
+// Unlike nquads.Parse, it rejects unquoted strings, which are ambiguous.
+func Parse(s string) (quad.Value, error) {
+	if !strings.HasPrefix(s, "<") && !strings.HasPrefix(s, "_:") && !strings.HasPrefix(s, `"`) {
+		return nil, fmt.Errorf("unquoted string: %q", s)
+	}
+	q, err := nquads.Parse("_:s <p> " + s + " .")
+	if err != nil {
+		return nil, err
+	} else if q.Object == nil {
+		return nil, quad.ErrInvalid
+	} else if _, ok := q.Object.(quad.String); ok && !strings.HasPrefix(s, `"`) {
+		return nil, fmt.Errorf("unquoted string: %q", s)
+	}
+	return q.Object, nil
+}
+
+// IsBlankLabel checks if a string can be used as a blank node label in N-Quads.
+func IsBlankLabel(s string) bool {
+	q, err := nquads.Parse("_:" + s + " <p> <o> .")
+	return err == nil && q.Subject == quad.BNode(s)
+}
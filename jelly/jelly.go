@@ -0,0 +1,199 @@
+// Package jelly implements the Jelly RDF streaming protobuf format.
+//
+// A stream is a sequence of varint-delimited frames, each containing rows with statements
+// and lookup table entries. IRIs are split into prefixes and names, which are encoded as
+// references to lookup tables, the same as literal datatypes. Terms repeated from the previous
+// statement are omitted.
+//
+// See https://w3id.org/jelly/dev/specification/serialization/ for the format specification.
+package jelly
+
+import (
+	"container/list"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+//go:generate protoc --go_opt=paths=source_relative --proto_path=. --go_out=. rdf.proto
+
+// AutoConvertTypedString allows to convert TypedString values to native
+// equivalents directly while parsing. It will call ToNative on all TypedString values.
+//
+// If conversion error occurs, it will preserve original TypedString value.
+var AutoConvertTypedString = true
+
+// DefaultMaxSize is a default limit for the size of a single stream frame.
+var DefaultMaxSize = 4 * 1024 * 1024
+
+// ContentType is a media type of Jelly streams.
+const ContentType = "application/x-jelly-rdf"
+
+const (
+	// currentVersion is the version of the protocol written by the encoder.
+	currentVersion = 1
+	// maxVersion is the latest version of the protocol supported by the decoder.
+	maxVersion = 2
+
+	// DefaultMaxNameTableSize is a default size of the name lookup table.
+	DefaultMaxNameTableSize = 4000
+	// DefaultMaxPrefixTableSize is a default size of the prefix lookup table.
+	DefaultMaxPrefixTableSize = 150
+	// DefaultMaxDatatypeTableSize is a default size of the datatype lookup table.
+	DefaultMaxDatatypeTableSize = 32
+	// DefaultFrameSize is a default number of rows in a single stream frame.
+	DefaultFrameSize = 256
+
+	// minNameTableSize is the minimal size of the name lookup table required by the specification.
+	minNameTableSize = 8
+)
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name: "jelly", Binary: true,
+		Ext:    []string{".jelly"},
+		Mime:   []string{ContentType},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w, nil) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r, DefaultMaxSize) },
+		Sniff:  sniff,
+	})
+}
+
+// sniff checks if the stream starts with a frame with stream options of a supported version,
+// which must be the first row of every stream.
+func sniff(head []byte) bool {
+	size, n := protowire.ConsumeVarint(head)
+	if n < 0 || size == 0 {
+		return false
+	}
+	head = head[n:]
+	// RdfStreamFrame.rows, then RdfStreamRow.options
+	for i := 0; i < 2; i++ {
+		num, typ, n := protowire.ConsumeTag(head)
+		if n < 0 || num != 1 || typ != protowire.BytesType {
+			return false
+		}
+		head = head[n:]
+		sub, n := protowire.ConsumeVarint(head)
+		if n < 0 || sub > size {
+			return false
+		}
+		head, size = head[n:], sub
+	}
+	if uint64(len(head)) > size {
+		head = head[:size]
+	}
+	for len(head) != 0 {
+		num, typ, n := protowire.ConsumeTag(head)
+		if n < 0 {
+			return false
+		}
+		head = head[n:]
+		if num == 15 && typ == protowire.VarintType { // RdfStreamOptions.version
+			v, n := protowire.ConsumeVarint(head)
+			return n > 0 && v >= 1 && v <= maxVersion
+		}
+		if n = protowire.ConsumeFieldValue(num, typ, head); n < 0 {
+			return false
+		}
+		head = head[n:]
+	}
+	return false
+}
+
+// splitIRI splits the IRI into a prefix and a name at the last '/' or '#'.
+func splitIRI(s string) (string, string) {
+	i := strings.LastIndexAny(s, "/#")
+	return s[:i+1], s[i+1:]
+}
+
+// lookup is an encoder lookup table with LRU eviction.
+type lookup struct {
+	max  int
+	ids  map[string]*list.Element
+	lru  *list.List // of *lookupEntry; most recent first
+	last uint32     // last id written in an entry row
+	gen  int        // current statement
+}
+
+type lookupEntry struct {
+	key string
+	id  uint32
+	gen int // statement that used the entry last
+}
+
+func newLookup(max int) *lookup {
+	return &lookup{max: max, ids: make(map[string]*list.Element), lru: list.New()}
+}
+
+// get returns an id for a key. If the key is not in the table, it's added and isNew is set.
+//
+// It fails if an entry used by the current statement would be evicted.
+func (l *lookup) get(key string) (id uint32, isNew bool, err error) {
+	if e, ok := l.ids[key]; ok {
+		l.lru.MoveToFront(e)
+		ent := e.Value.(*lookupEntry)
+		ent.gen = l.gen
+		return ent.id, false, nil
+	}
+	var ent *lookupEntry
+	if l.lru.Len() < l.max {
+		ent = &lookupEntry{id: uint32(l.lru.Len() + 1)}
+	} else {
+		e := l.lru.Back()
+		ent = e.Value.(*lookupEntry)
+		if ent.gen == l.gen {
+			return 0, false, fmt.Errorf("lookup table of size %d is too small for a statement", l.max)
+		}
+		l.lru.Remove(e)
+		delete(l.ids, ent.key)
+	}
+	ent.key, ent.gen = key, l.gen
+	l.ids[key] = l.lru.PushFront(ent)
+	return ent.id, true, nil
+}
+
+// entryID returns an id to write in an entry row, using 0 for sequential ids.
+func (l *lookup) entryID(id uint32) uint32 {
+	prev := l.last
+	l.last = id
+	if id == prev+1 {
+		return 0
+	}
+	return id
+}
+
+// table is a decoder lookup table.
+type table struct {
+	name string
+	vals []string
+	set  []bool
+	last uint32 // last id set by an entry row
+}
+
+func newTable(name string, max uint32) *table {
+	return &table{name: name, vals: make([]string, max+1), set: make([]bool, max+1)}
+}
+
+// put sets an entry of the table. Id 0 means "previous id + 1".
+func (t *table) put(id uint32, v string) error {
+	if id == 0 {
+		id = t.last + 1
+	}
+	if int(id) >= len(t.vals) {
+		return fmt.Errorf("%s id %d is out of range", t.name, id)
+	}
+	t.vals[id], t.set[id], t.last = v, true, id
+	return nil
+}
+
+// get returns an entry of the table.
+func (t *table) get(id uint32) (string, error) {
+	if int(id) >= len(t.vals) || !t.set[id] {
+		return "", fmt.Errorf("unknown %s id: %d", t.name, id)
+	}
+	return t.vals[id], nil
+}
@@ -0,0 +1,149 @@
+package jelly_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/jelly"
+	"github.com/cayleygraph/quad/pquads/pio"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+var testQuads = []quad.Quad{
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob")},
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("carol")},
+	{Subject: iri("alice"), Predicate: iri("friend"), Object: quad.BNode("dave")},
+	{Subject: quad.BNode("dave"), Predicate: iri("name"), Object: quad.String("Dave \"D\"\n")},
+	{Subject: quad.BNode("dave"), Predicate: iri("title"), Object: quad.LangString{Value: "Dr", Lang: "en"}, Label: iri("g")},
+	{Subject: quad.BNode("dave"), Predicate: iri("born"), Object: quad.TypedString{Value: "1990-07", Type: "http://www.w3.org/2001/XMLSchema#gYearMonth"}, Label: quad.BNode("g2")},
+	{Subject: quad.BNode("dave"), Predicate: iri("age"), Object: quad.Int(42), Label: quad.BNode("g2")},
+	{Subject: quad.BNode("dave"), Predicate: iri("height"), Object: quad.Float(1.75)},
+	{Subject: quad.BNode("dave"), Predicate: iri("active"), Object: quad.Bool(true)},
+	{Subject: quad.BNode("dave"), Predicate: iri("seen"), Object: quad.Time(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))},
+	{Subject: quad.IRI("urn:x"), Predicate: quad.IRI("http://example.org/ns#p"), Object: quad.IRI("http://example.org/ns#p")},
+}
+
+func roundTrip(t testing.TB, opts *jelly.Options, quads []quad.Quad) []quad.Quad {
+	buf := bytes.NewBuffer(nil)
+	w := jelly.NewWriter(buf, opts)
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	r := jelly.NewReader(buf, 0)
+	defer r.Close()
+	out, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	return out
+}
+
+func TestRoundTrip(t *testing.T) {
+	got := roundTrip(t, nil, testQuads)
+	require.Equal(t, testQuads, got)
+}
+
+func TestSmallTables(t *testing.T) {
+	var quads []quad.Quad
+	for i := 0; i < 50; i++ {
+		quads = append(quads, quad.Quad{
+			Subject:   quad.IRI(fmt.Sprintf("http://example.org/%d/s%d", i%7, i)),
+			Predicate: iri(fmt.Sprintf("p%d", i%3)),
+			Object:    quad.TypedString{Value: quad.String(fmt.Sprint(i)), Type: quad.IRI(fmt.Sprintf("http://example.org/t%d", i%5))},
+			Label:     iri(fmt.Sprintf("g%d", i%2)),
+		})
+	}
+	got := roundTrip(t, &jelly.Options{
+		MaxNameTableSize: 8, MaxPrefixTableSize: 4, MaxDatatypeTableSize: 2, FrameSize: 3,
+	}, quads)
+	require.Equal(t, quads, got)
+}
+
+func TestElision(t *testing.T) {
+	quads := []quad.Quad{
+		{Subject: iri("a"), Predicate: iri("p"), Object: iri("b")},
+		{Subject: iri("a"), Predicate: iri("p"), Object: iri("c")},
+	}
+	buf := bytes.NewBuffer(nil)
+	w := jelly.NewWriter(buf, nil)
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+
+	var f jelly.RdfStreamFrame
+	require.NoError(t, pio.NewReader(buf, jelly.DefaultMaxSize).ReadMsg(&f))
+	var rows []*jelly.RdfQuad
+	for _, row := range f.Rows {
+		if q := row.GetQuad(); q != nil {
+			rows = append(rows, q)
+		}
+	}
+	require.Len(t, rows, 2)
+	last := rows[1]
+	require.Nil(t, last.Subject)
+	require.Nil(t, last.Predicate)
+	require.Nil(t, last.Graph)
+	require.NotNil(t, last.GetOIri())
+}
+
+func TestQuotedTriples(t *testing.T) {
+	tr := quad.Triple{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.String("bob")}
+	quads := []quad.Quad{
+		{Subject: tr, Predicate: iri("certainty"), Object: quad.Float(0.5)},
+		{Subject: iri("carol"), Predicate: iri("says"), Object: quad.Triple{Subject: tr, Predicate: iri("is"), Object: iri("true")}},
+	}
+	buf := bytes.NewBuffer(nil)
+	w := jelly.NewWriter(buf, nil)
+	require.Error(t, w.WriteQuad(quads[0]))
+
+	got := roundTrip(t, &jelly.Options{RDFStar: true}, quads)
+	require.Equal(t, quads, got)
+}
+
+func TestGeneralized(t *testing.T) {
+	quads := []quad.Quad{
+		{Subject: quad.String("a"), Predicate: quad.BNode("p"), Object: iri("b"), Label: quad.Int(1)},
+	}
+	buf := bytes.NewBuffer(nil)
+	w := jelly.NewWriter(buf, nil)
+	require.Error(t, w.WriteQuad(quads[0]))
+
+	got := roundTrip(t, &jelly.Options{Generalized: true}, quads)
+	require.Equal(t, quads, got)
+}
+
+// TestReference reads a stream encoded the same way as reference encoders, see testdata/gen.go.
+func TestReference(t *testing.T) {
+	f, err := os.Open("testdata/example.jelly")
+	require.NoError(t, err)
+	defer f.Close()
+	r := jelly.NewReader(f, 0)
+	defer r.Close()
+	got, err := quad.ReadAll(r)
+	require.NoError(t, err)
+
+	foaf := func(s string) quad.IRI { return quad.IRI("http://xmlns.com/foaf/0.1/" + s) }
+	require.Equal(t, []quad.Quad{
+		{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob")},
+		{Subject: iri("alice"), Predicate: foaf("name"), Object: quad.LangString{Value: "Alice", Lang: "en"}},
+		{Subject: quad.BNode("b1"), Predicate: foaf("age"), Object: quad.Int(42), Label: iri("g")},
+		{Subject: quad.BNode("b1"), Predicate: iri("knows"), Object: iri("alice"), Label: iri("g")},
+	}, got)
+
+	opts := r.Options()
+	require.Equal(t, jelly.PhysicalStreamType_PHYSICAL_STREAM_TYPE_QUADS, opts.PhysicalType)
+	require.Equal(t, uint32(128), opts.MaxNameTableSize)
+}
+
+func TestFormat(t *testing.T) {
+	f := quad.FormatByMime(jelly.ContentType)
+	require.NotNil(t, f)
+	require.Equal(t, "jelly", f.Name)
+	require.Equal(t, f, quad.FormatByExt(".jelly"))
+}
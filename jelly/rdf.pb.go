@@ -0,0 +1,2210 @@
+// Wire-compatible definition of the Jelly RDF streaming protocol.
+//
+// See https://w3id.org/jelly/dev/specification/serialization/ for the format specification.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.2
+// 	protoc        v4.23.4
+// source: rdf.proto
+
+package jelly
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type PhysicalStreamType int32
+
+const (
+	PhysicalStreamType_PHYSICAL_STREAM_TYPE_UNSPECIFIED PhysicalStreamType = 0
+	PhysicalStreamType_PHYSICAL_STREAM_TYPE_TRIPLES     PhysicalStreamType = 1
+	PhysicalStreamType_PHYSICAL_STREAM_TYPE_QUADS       PhysicalStreamType = 2
+	PhysicalStreamType_PHYSICAL_STREAM_TYPE_GRAPHS      PhysicalStreamType = 3
+)
+
+// Enum value maps for PhysicalStreamType.
+var (
+	PhysicalStreamType_name = map[int32]string{
+		0: "PHYSICAL_STREAM_TYPE_UNSPECIFIED",
+		1: "PHYSICAL_STREAM_TYPE_TRIPLES",
+		2: "PHYSICAL_STREAM_TYPE_QUADS",
+		3: "PHYSICAL_STREAM_TYPE_GRAPHS",
+	}
+	PhysicalStreamType_value = map[string]int32{
+		"PHYSICAL_STREAM_TYPE_UNSPECIFIED": 0,
+		"PHYSICAL_STREAM_TYPE_TRIPLES":     1,
+		"PHYSICAL_STREAM_TYPE_QUADS":       2,
+		"PHYSICAL_STREAM_TYPE_GRAPHS":      3,
+	}
+)
+
+func (x PhysicalStreamType) Enum() *PhysicalStreamType {
+	p := new(PhysicalStreamType)
+	*p = x
+	return p
+}
+
+func (x PhysicalStreamType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PhysicalStreamType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rdf_proto_enumTypes[0].Descriptor()
+}
+
+func (PhysicalStreamType) Type() protoreflect.EnumType {
+	return &file_rdf_proto_enumTypes[0]
+}
+
+func (x PhysicalStreamType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PhysicalStreamType.Descriptor instead.
+func (PhysicalStreamType) EnumDescriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{0}
+}
+
+type LogicalStreamType int32
+
+const (
+	LogicalStreamType_LOGICAL_STREAM_TYPE_UNSPECIFIED  LogicalStreamType = 0
+	LogicalStreamType_LOGICAL_STREAM_TYPE_FLAT_TRIPLES LogicalStreamType = 1
+	LogicalStreamType_LOGICAL_STREAM_TYPE_FLAT_QUADS   LogicalStreamType = 2
+	LogicalStreamType_LOGICAL_STREAM_TYPE_GRAPHS       LogicalStreamType = 3
+	LogicalStreamType_LOGICAL_STREAM_TYPE_DATASETS     LogicalStreamType = 4
+)
+
+// Enum value maps for LogicalStreamType.
+var (
+	LogicalStreamType_name = map[int32]string{
+		0: "LOGICAL_STREAM_TYPE_UNSPECIFIED",
+		1: "LOGICAL_STREAM_TYPE_FLAT_TRIPLES",
+		2: "LOGICAL_STREAM_TYPE_FLAT_QUADS",
+		3: "LOGICAL_STREAM_TYPE_GRAPHS",
+		4: "LOGICAL_STREAM_TYPE_DATASETS",
+	}
+	LogicalStreamType_value = map[string]int32{
+		"LOGICAL_STREAM_TYPE_UNSPECIFIED":  0,
+		"LOGICAL_STREAM_TYPE_FLAT_TRIPLES": 1,
+		"LOGICAL_STREAM_TYPE_FLAT_QUADS":   2,
+		"LOGICAL_STREAM_TYPE_GRAPHS":       3,
+		"LOGICAL_STREAM_TYPE_DATASETS":     4,
+	}
+)
+
+func (x LogicalStreamType) Enum() *LogicalStreamType {
+	p := new(LogicalStreamType)
+	*p = x
+	return p
+}
+
+func (x LogicalStreamType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LogicalStreamType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rdf_proto_enumTypes[1].Descriptor()
+}
+
+func (LogicalStreamType) Type() protoreflect.EnumType {
+	return &file_rdf_proto_enumTypes[1]
+}
+
+func (x LogicalStreamType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LogicalStreamType.Descriptor instead.
+func (LogicalStreamType) EnumDescriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{1}
+}
+
+// RdfIri is an IRI split into a prefix and a name, both encoded as lookup table references.
+type RdfIri struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// 0 means "same as in the previous IRI".
+	PrefixId uint32 `protobuf:"varint,1,opt,name=prefix_id,json=prefixId,proto3" json:"prefix_id,omitempty"`
+	// 0 means "previous name id + 1".
+	NameId uint32 `protobuf:"varint,2,opt,name=name_id,json=nameId,proto3" json:"name_id,omitempty"`
+}
+
+func (x *RdfIri) Reset() {
+	*x = RdfIri{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfIri) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfIri) ProtoMessage() {}
+
+func (x *RdfIri) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfIri.ProtoReflect.Descriptor instead.
+func (*RdfIri) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *RdfIri) GetPrefixId() uint32 {
+	if x != nil {
+		return x.PrefixId
+	}
+	return 0
+}
+
+func (x *RdfIri) GetNameId() uint32 {
+	if x != nil {
+		return x.NameId
+	}
+	return 0
+}
+
+// RdfLiteral is an RDF literal. If neither langtag nor datatype is set, it's a simple literal.
+type RdfLiteral struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lex string `protobuf:"bytes,1,opt,name=lex,proto3" json:"lex,omitempty"`
+	// Types that are assignable to LiteralKind:
+	//
+	//	*RdfLiteral_Langtag
+	//	*RdfLiteral_Datatype
+	LiteralKind isRdfLiteral_LiteralKind `protobuf_oneof:"literalKind"`
+}
+
+func (x *RdfLiteral) Reset() {
+	*x = RdfLiteral{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfLiteral) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfLiteral) ProtoMessage() {}
+
+func (x *RdfLiteral) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfLiteral.ProtoReflect.Descriptor instead.
+func (*RdfLiteral) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RdfLiteral) GetLex() string {
+	if x != nil {
+		return x.Lex
+	}
+	return ""
+}
+
+func (m *RdfLiteral) GetLiteralKind() isRdfLiteral_LiteralKind {
+	if m != nil {
+		return m.LiteralKind
+	}
+	return nil
+}
+
+func (x *RdfLiteral) GetLangtag() string {
+	if x, ok := x.GetLiteralKind().(*RdfLiteral_Langtag); ok {
+		return x.Langtag
+	}
+	return ""
+}
+
+func (x *RdfLiteral) GetDatatype() uint32 {
+	if x, ok := x.GetLiteralKind().(*RdfLiteral_Datatype); ok {
+		return x.Datatype
+	}
+	return 0
+}
+
+type isRdfLiteral_LiteralKind interface {
+	isRdfLiteral_LiteralKind()
+}
+
+type RdfLiteral_Langtag struct {
+	Langtag string `protobuf:"bytes,2,opt,name=langtag,proto3,oneof"`
+}
+
+type RdfLiteral_Datatype struct {
+	Datatype uint32 `protobuf:"varint,3,opt,name=datatype,proto3,oneof"`
+}
+
+func (*RdfLiteral_Langtag) isRdfLiteral_LiteralKind() {}
+
+func (*RdfLiteral_Datatype) isRdfLiteral_LiteralKind() {}
+
+// RdfDefaultGraph is a marker of the default graph.
+type RdfDefaultGraph struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RdfDefaultGraph) Reset() {
+	*x = RdfDefaultGraph{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfDefaultGraph) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfDefaultGraph) ProtoMessage() {}
+
+func (x *RdfDefaultGraph) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfDefaultGraph.ProtoReflect.Descriptor instead.
+func (*RdfDefaultGraph) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{2}
+}
+
+// RdfTriple is an RDF triple. Unset terms are the same as in the previous triple.
+type RdfTriple struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Subject:
+	//
+	//	*RdfTriple_SIri
+	//	*RdfTriple_SBnode
+	//	*RdfTriple_SLiteral
+	//	*RdfTriple_STripleTerm
+	Subject isRdfTriple_Subject `protobuf_oneof:"subject"`
+	// Types that are assignable to Predicate:
+	//
+	//	*RdfTriple_PIri
+	//	*RdfTriple_PBnode
+	//	*RdfTriple_PLiteral
+	//	*RdfTriple_PTripleTerm
+	Predicate isRdfTriple_Predicate `protobuf_oneof:"predicate"`
+	// Types that are assignable to Object:
+	//
+	//	*RdfTriple_OIri
+	//	*RdfTriple_OBnode
+	//	*RdfTriple_OLiteral
+	//	*RdfTriple_OTripleTerm
+	Object isRdfTriple_Object `protobuf_oneof:"object"`
+}
+
+func (x *RdfTriple) Reset() {
+	*x = RdfTriple{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfTriple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfTriple) ProtoMessage() {}
+
+func (x *RdfTriple) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfTriple.ProtoReflect.Descriptor instead.
+func (*RdfTriple) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *RdfTriple) GetSubject() isRdfTriple_Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (x *RdfTriple) GetSIri() *RdfIri {
+	if x, ok := x.GetSubject().(*RdfTriple_SIri); ok {
+		return x.SIri
+	}
+	return nil
+}
+
+func (x *RdfTriple) GetSBnode() string {
+	if x, ok := x.GetSubject().(*RdfTriple_SBnode); ok {
+		return x.SBnode
+	}
+	return ""
+}
+
+func (x *RdfTriple) GetSLiteral() *RdfLiteral {
+	if x, ok := x.GetSubject().(*RdfTriple_SLiteral); ok {
+		return x.SLiteral
+	}
+	return nil
+}
+
+func (x *RdfTriple) GetSTripleTerm() *RdfTriple {
+	if x, ok := x.GetSubject().(*RdfTriple_STripleTerm); ok {
+		return x.STripleTerm
+	}
+	return nil
+}
+
+func (m *RdfTriple) GetPredicate() isRdfTriple_Predicate {
+	if m != nil {
+		return m.Predicate
+	}
+	return nil
+}
+
+func (x *RdfTriple) GetPIri() *RdfIri {
+	if x, ok := x.GetPredicate().(*RdfTriple_PIri); ok {
+		return x.PIri
+	}
+	return nil
+}
+
+func (x *RdfTriple) GetPBnode() string {
+	if x, ok := x.GetPredicate().(*RdfTriple_PBnode); ok {
+		return x.PBnode
+	}
+	return ""
+}
+
+func (x *RdfTriple) GetPLiteral() *RdfLiteral {
+	if x, ok := x.GetPredicate().(*RdfTriple_PLiteral); ok {
+		return x.PLiteral
+	}
+	return nil
+}
+
+func (x *RdfTriple) GetPTripleTerm() *RdfTriple {
+	if x, ok := x.GetPredicate().(*RdfTriple_PTripleTerm); ok {
+		return x.PTripleTerm
+	}
+	return nil
+}
+
+func (m *RdfTriple) GetObject() isRdfTriple_Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (x *RdfTriple) GetOIri() *RdfIri {
+	if x, ok := x.GetObject().(*RdfTriple_OIri); ok {
+		return x.OIri
+	}
+	return nil
+}
+
+func (x *RdfTriple) GetOBnode() string {
+	if x, ok := x.GetObject().(*RdfTriple_OBnode); ok {
+		return x.OBnode
+	}
+	return ""
+}
+
+func (x *RdfTriple) GetOLiteral() *RdfLiteral {
+	if x, ok := x.GetObject().(*RdfTriple_OLiteral); ok {
+		return x.OLiteral
+	}
+	return nil
+}
+
+func (x *RdfTriple) GetOTripleTerm() *RdfTriple {
+	if x, ok := x.GetObject().(*RdfTriple_OTripleTerm); ok {
+		return x.OTripleTerm
+	}
+	return nil
+}
+
+type isRdfTriple_Subject interface {
+	isRdfTriple_Subject()
+}
+
+type RdfTriple_SIri struct {
+	SIri *RdfIri `protobuf:"bytes,1,opt,name=s_iri,json=sIri,proto3,oneof"`
+}
+
+type RdfTriple_SBnode struct {
+	SBnode string `protobuf:"bytes,2,opt,name=s_bnode,json=sBnode,proto3,oneof"`
+}
+
+type RdfTriple_SLiteral struct {
+	SLiteral *RdfLiteral `protobuf:"bytes,3,opt,name=s_literal,json=sLiteral,proto3,oneof"`
+}
+
+type RdfTriple_STripleTerm struct {
+	STripleTerm *RdfTriple `protobuf:"bytes,4,opt,name=s_triple_term,json=sTripleTerm,proto3,oneof"`
+}
+
+func (*RdfTriple_SIri) isRdfTriple_Subject() {}
+
+func (*RdfTriple_SBnode) isRdfTriple_Subject() {}
+
+func (*RdfTriple_SLiteral) isRdfTriple_Subject() {}
+
+func (*RdfTriple_STripleTerm) isRdfTriple_Subject() {}
+
+type isRdfTriple_Predicate interface {
+	isRdfTriple_Predicate()
+}
+
+type RdfTriple_PIri struct {
+	PIri *RdfIri `protobuf:"bytes,5,opt,name=p_iri,json=pIri,proto3,oneof"`
+}
+
+type RdfTriple_PBnode struct {
+	PBnode string `protobuf:"bytes,6,opt,name=p_bnode,json=pBnode,proto3,oneof"`
+}
+
+type RdfTriple_PLiteral struct {
+	PLiteral *RdfLiteral `protobuf:"bytes,7,opt,name=p_literal,json=pLiteral,proto3,oneof"`
+}
+
+type RdfTriple_PTripleTerm struct {
+	PTripleTerm *RdfTriple `protobuf:"bytes,8,opt,name=p_triple_term,json=pTripleTerm,proto3,oneof"`
+}
+
+func (*RdfTriple_PIri) isRdfTriple_Predicate() {}
+
+func (*RdfTriple_PBnode) isRdfTriple_Predicate() {}
+
+func (*RdfTriple_PLiteral) isRdfTriple_Predicate() {}
+
+func (*RdfTriple_PTripleTerm) isRdfTriple_Predicate() {}
+
+type isRdfTriple_Object interface {
+	isRdfTriple_Object()
+}
+
+type RdfTriple_OIri struct {
+	OIri *RdfIri `protobuf:"bytes,9,opt,name=o_iri,json=oIri,proto3,oneof"`
+}
+
+type RdfTriple_OBnode struct {
+	OBnode string `protobuf:"bytes,10,opt,name=o_bnode,json=oBnode,proto3,oneof"`
+}
+
+type RdfTriple_OLiteral struct {
+	OLiteral *RdfLiteral `protobuf:"bytes,11,opt,name=o_literal,json=oLiteral,proto3,oneof"`
+}
+
+type RdfTriple_OTripleTerm struct {
+	OTripleTerm *RdfTriple `protobuf:"bytes,12,opt,name=o_triple_term,json=oTripleTerm,proto3,oneof"`
+}
+
+func (*RdfTriple_OIri) isRdfTriple_Object() {}
+
+func (*RdfTriple_OBnode) isRdfTriple_Object() {}
+
+func (*RdfTriple_OLiteral) isRdfTriple_Object() {}
+
+func (*RdfTriple_OTripleTerm) isRdfTriple_Object() {}
+
+// RdfQuad is an RDF quad. Unset terms are the same as in the previous quad.
+type RdfQuad struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Subject:
+	//
+	//	*RdfQuad_SIri
+	//	*RdfQuad_SBnode
+	//	*RdfQuad_SLiteral
+	//	*RdfQuad_STripleTerm
+	Subject isRdfQuad_Subject `protobuf_oneof:"subject"`
+	// Types that are assignable to Predicate:
+	//
+	//	*RdfQuad_PIri
+	//	*RdfQuad_PBnode
+	//	*RdfQuad_PLiteral
+	//	*RdfQuad_PTripleTerm
+	Predicate isRdfQuad_Predicate `protobuf_oneof:"predicate"`
+	// Types that are assignable to Object:
+	//
+	//	*RdfQuad_OIri
+	//	*RdfQuad_OBnode
+	//	*RdfQuad_OLiteral
+	//	*RdfQuad_OTripleTerm
+	Object isRdfQuad_Object `protobuf_oneof:"object"`
+	// Types that are assignable to Graph:
+	//
+	//	*RdfQuad_GIri
+	//	*RdfQuad_GBnode
+	//	*RdfQuad_GDefaultGraph
+	//	*RdfQuad_GLiteral
+	Graph isRdfQuad_Graph `protobuf_oneof:"graph"`
+}
+
+func (x *RdfQuad) Reset() {
+	*x = RdfQuad{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfQuad) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfQuad) ProtoMessage() {}
+
+func (x *RdfQuad) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfQuad.ProtoReflect.Descriptor instead.
+func (*RdfQuad) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{4}
+}
+
+func (m *RdfQuad) GetSubject() isRdfQuad_Subject {
+	if m != nil {
+		return m.Subject
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetSIri() *RdfIri {
+	if x, ok := x.GetSubject().(*RdfQuad_SIri); ok {
+		return x.SIri
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetSBnode() string {
+	if x, ok := x.GetSubject().(*RdfQuad_SBnode); ok {
+		return x.SBnode
+	}
+	return ""
+}
+
+func (x *RdfQuad) GetSLiteral() *RdfLiteral {
+	if x, ok := x.GetSubject().(*RdfQuad_SLiteral); ok {
+		return x.SLiteral
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetSTripleTerm() *RdfTriple {
+	if x, ok := x.GetSubject().(*RdfQuad_STripleTerm); ok {
+		return x.STripleTerm
+	}
+	return nil
+}
+
+func (m *RdfQuad) GetPredicate() isRdfQuad_Predicate {
+	if m != nil {
+		return m.Predicate
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetPIri() *RdfIri {
+	if x, ok := x.GetPredicate().(*RdfQuad_PIri); ok {
+		return x.PIri
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetPBnode() string {
+	if x, ok := x.GetPredicate().(*RdfQuad_PBnode); ok {
+		return x.PBnode
+	}
+	return ""
+}
+
+func (x *RdfQuad) GetPLiteral() *RdfLiteral {
+	if x, ok := x.GetPredicate().(*RdfQuad_PLiteral); ok {
+		return x.PLiteral
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetPTripleTerm() *RdfTriple {
+	if x, ok := x.GetPredicate().(*RdfQuad_PTripleTerm); ok {
+		return x.PTripleTerm
+	}
+	return nil
+}
+
+func (m *RdfQuad) GetObject() isRdfQuad_Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetOIri() *RdfIri {
+	if x, ok := x.GetObject().(*RdfQuad_OIri); ok {
+		return x.OIri
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetOBnode() string {
+	if x, ok := x.GetObject().(*RdfQuad_OBnode); ok {
+		return x.OBnode
+	}
+	return ""
+}
+
+func (x *RdfQuad) GetOLiteral() *RdfLiteral {
+	if x, ok := x.GetObject().(*RdfQuad_OLiteral); ok {
+		return x.OLiteral
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetOTripleTerm() *RdfTriple {
+	if x, ok := x.GetObject().(*RdfQuad_OTripleTerm); ok {
+		return x.OTripleTerm
+	}
+	return nil
+}
+
+func (m *RdfQuad) GetGraph() isRdfQuad_Graph {
+	if m != nil {
+		return m.Graph
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetGIri() *RdfIri {
+	if x, ok := x.GetGraph().(*RdfQuad_GIri); ok {
+		return x.GIri
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetGBnode() string {
+	if x, ok := x.GetGraph().(*RdfQuad_GBnode); ok {
+		return x.GBnode
+	}
+	return ""
+}
+
+func (x *RdfQuad) GetGDefaultGraph() *RdfDefaultGraph {
+	if x, ok := x.GetGraph().(*RdfQuad_GDefaultGraph); ok {
+		return x.GDefaultGraph
+	}
+	return nil
+}
+
+func (x *RdfQuad) GetGLiteral() *RdfLiteral {
+	if x, ok := x.GetGraph().(*RdfQuad_GLiteral); ok {
+		return x.GLiteral
+	}
+	return nil
+}
+
+type isRdfQuad_Subject interface {
+	isRdfQuad_Subject()
+}
+
+type RdfQuad_SIri struct {
+	SIri *RdfIri `protobuf:"bytes,1,opt,name=s_iri,json=sIri,proto3,oneof"`
+}
+
+type RdfQuad_SBnode struct {
+	SBnode string `protobuf:"bytes,2,opt,name=s_bnode,json=sBnode,proto3,oneof"`
+}
+
+type RdfQuad_SLiteral struct {
+	SLiteral *RdfLiteral `protobuf:"bytes,3,opt,name=s_literal,json=sLiteral,proto3,oneof"`
+}
+
+type RdfQuad_STripleTerm struct {
+	STripleTerm *RdfTriple `protobuf:"bytes,4,opt,name=s_triple_term,json=sTripleTerm,proto3,oneof"`
+}
+
+func (*RdfQuad_SIri) isRdfQuad_Subject() {}
+
+func (*RdfQuad_SBnode) isRdfQuad_Subject() {}
+
+func (*RdfQuad_SLiteral) isRdfQuad_Subject() {}
+
+func (*RdfQuad_STripleTerm) isRdfQuad_Subject() {}
+
+type isRdfQuad_Predicate interface {
+	isRdfQuad_Predicate()
+}
+
+type RdfQuad_PIri struct {
+	PIri *RdfIri `protobuf:"bytes,5,opt,name=p_iri,json=pIri,proto3,oneof"`
+}
+
+type RdfQuad_PBnode struct {
+	PBnode string `protobuf:"bytes,6,opt,name=p_bnode,json=pBnode,proto3,oneof"`
+}
+
+type RdfQuad_PLiteral struct {
+	PLiteral *RdfLiteral `protobuf:"bytes,7,opt,name=p_literal,json=pLiteral,proto3,oneof"`
+}
+
+type RdfQuad_PTripleTerm struct {
+	PTripleTerm *RdfTriple `protobuf:"bytes,8,opt,name=p_triple_term,json=pTripleTerm,proto3,oneof"`
+}
+
+func (*RdfQuad_PIri) isRdfQuad_Predicate() {}
+
+func (*RdfQuad_PBnode) isRdfQuad_Predicate() {}
+
+func (*RdfQuad_PLiteral) isRdfQuad_Predicate() {}
+
+func (*RdfQuad_PTripleTerm) isRdfQuad_Predicate() {}
+
+type isRdfQuad_Object interface {
+	isRdfQuad_Object()
+}
+
+type RdfQuad_OIri struct {
+	OIri *RdfIri `protobuf:"bytes,9,opt,name=o_iri,json=oIri,proto3,oneof"`
+}
+
+type RdfQuad_OBnode struct {
+	OBnode string `protobuf:"bytes,10,opt,name=o_bnode,json=oBnode,proto3,oneof"`
+}
+
+type RdfQuad_OLiteral struct {
+	OLiteral *RdfLiteral `protobuf:"bytes,11,opt,name=o_literal,json=oLiteral,proto3,oneof"`
+}
+
+type RdfQuad_OTripleTerm struct {
+	OTripleTerm *RdfTriple `protobuf:"bytes,12,opt,name=o_triple_term,json=oTripleTerm,proto3,oneof"`
+}
+
+func (*RdfQuad_OIri) isRdfQuad_Object() {}
+
+func (*RdfQuad_OBnode) isRdfQuad_Object() {}
+
+func (*RdfQuad_OLiteral) isRdfQuad_Object() {}
+
+func (*RdfQuad_OTripleTerm) isRdfQuad_Object() {}
+
+type isRdfQuad_Graph interface {
+	isRdfQuad_Graph()
+}
+
+type RdfQuad_GIri struct {
+	GIri *RdfIri `protobuf:"bytes,13,opt,name=g_iri,json=gIri,proto3,oneof"`
+}
+
+type RdfQuad_GBnode struct {
+	GBnode string `protobuf:"bytes,14,opt,name=g_bnode,json=gBnode,proto3,oneof"`
+}
+
+type RdfQuad_GDefaultGraph struct {
+	GDefaultGraph *RdfDefaultGraph `protobuf:"bytes,15,opt,name=g_default_graph,json=gDefaultGraph,proto3,oneof"`
+}
+
+type RdfQuad_GLiteral struct {
+	GLiteral *RdfLiteral `protobuf:"bytes,16,opt,name=g_literal,json=gLiteral,proto3,oneof"`
+}
+
+func (*RdfQuad_GIri) isRdfQuad_Graph() {}
+
+func (*RdfQuad_GBnode) isRdfQuad_Graph() {}
+
+func (*RdfQuad_GDefaultGraph) isRdfQuad_Graph() {}
+
+func (*RdfQuad_GLiteral) isRdfQuad_Graph() {}
+
+// RdfGraphStart starts a graph in a GRAPHS stream.
+type RdfGraphStart struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Graph:
+	//
+	//	*RdfGraphStart_GIri
+	//	*RdfGraphStart_GBnode
+	//	*RdfGraphStart_GDefaultGraph
+	//	*RdfGraphStart_GLiteral
+	Graph isRdfGraphStart_Graph `protobuf_oneof:"graph"`
+}
+
+func (x *RdfGraphStart) Reset() {
+	*x = RdfGraphStart{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfGraphStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfGraphStart) ProtoMessage() {}
+
+func (x *RdfGraphStart) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfGraphStart.ProtoReflect.Descriptor instead.
+func (*RdfGraphStart) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{5}
+}
+
+func (m *RdfGraphStart) GetGraph() isRdfGraphStart_Graph {
+	if m != nil {
+		return m.Graph
+	}
+	return nil
+}
+
+func (x *RdfGraphStart) GetGIri() *RdfIri {
+	if x, ok := x.GetGraph().(*RdfGraphStart_GIri); ok {
+		return x.GIri
+	}
+	return nil
+}
+
+func (x *RdfGraphStart) GetGBnode() string {
+	if x, ok := x.GetGraph().(*RdfGraphStart_GBnode); ok {
+		return x.GBnode
+	}
+	return ""
+}
+
+func (x *RdfGraphStart) GetGDefaultGraph() *RdfDefaultGraph {
+	if x, ok := x.GetGraph().(*RdfGraphStart_GDefaultGraph); ok {
+		return x.GDefaultGraph
+	}
+	return nil
+}
+
+func (x *RdfGraphStart) GetGLiteral() *RdfLiteral {
+	if x, ok := x.GetGraph().(*RdfGraphStart_GLiteral); ok {
+		return x.GLiteral
+	}
+	return nil
+}
+
+type isRdfGraphStart_Graph interface {
+	isRdfGraphStart_Graph()
+}
+
+type RdfGraphStart_GIri struct {
+	GIri *RdfIri `protobuf:"bytes,1,opt,name=g_iri,json=gIri,proto3,oneof"`
+}
+
+type RdfGraphStart_GBnode struct {
+	GBnode string `protobuf:"bytes,2,opt,name=g_bnode,json=gBnode,proto3,oneof"`
+}
+
+type RdfGraphStart_GDefaultGraph struct {
+	GDefaultGraph *RdfDefaultGraph `protobuf:"bytes,3,opt,name=g_default_graph,json=gDefaultGraph,proto3,oneof"`
+}
+
+type RdfGraphStart_GLiteral struct {
+	GLiteral *RdfLiteral `protobuf:"bytes,4,opt,name=g_literal,json=gLiteral,proto3,oneof"`
+}
+
+func (*RdfGraphStart_GIri) isRdfGraphStart_Graph() {}
+
+func (*RdfGraphStart_GBnode) isRdfGraphStart_Graph() {}
+
+func (*RdfGraphStart_GDefaultGraph) isRdfGraphStart_Graph() {}
+
+func (*RdfGraphStart_GLiteral) isRdfGraphStart_Graph() {}
+
+// RdfGraphEnd ends the current graph in a GRAPHS stream.
+type RdfGraphEnd struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RdfGraphEnd) Reset() {
+	*x = RdfGraphEnd{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfGraphEnd) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfGraphEnd) ProtoMessage() {}
+
+func (x *RdfGraphEnd) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfGraphEnd.ProtoReflect.Descriptor instead.
+func (*RdfGraphEnd) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{6}
+}
+
+// RdfNamespaceDeclaration is a namespace declaration. It carries no data.
+type RdfNamespaceDeclaration struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string  `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value *RdfIri `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RdfNamespaceDeclaration) Reset() {
+	*x = RdfNamespaceDeclaration{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfNamespaceDeclaration) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfNamespaceDeclaration) ProtoMessage() {}
+
+func (x *RdfNamespaceDeclaration) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfNamespaceDeclaration.ProtoReflect.Descriptor instead.
+func (*RdfNamespaceDeclaration) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RdfNamespaceDeclaration) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RdfNamespaceDeclaration) GetValue() *RdfIri {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// RdfNameEntry sets an entry of the name lookup table. Id 0 means "previous id + 1".
+type RdfNameEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RdfNameEntry) Reset() {
+	*x = RdfNameEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfNameEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfNameEntry) ProtoMessage() {}
+
+func (x *RdfNameEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfNameEntry.ProtoReflect.Descriptor instead.
+func (*RdfNameEntry) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *RdfNameEntry) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RdfNameEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// RdfPrefixEntry sets an entry of the prefix lookup table. Id 0 means "previous id + 1".
+type RdfPrefixEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RdfPrefixEntry) Reset() {
+	*x = RdfPrefixEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfPrefixEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfPrefixEntry) ProtoMessage() {}
+
+func (x *RdfPrefixEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfPrefixEntry.ProtoReflect.Descriptor instead.
+func (*RdfPrefixEntry) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *RdfPrefixEntry) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RdfPrefixEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// RdfDatatypeEntry sets an entry of the datatype lookup table. Id 0 means "previous id + 1".
+type RdfDatatypeEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id    uint32 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RdfDatatypeEntry) Reset() {
+	*x = RdfDatatypeEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfDatatypeEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfDatatypeEntry) ProtoMessage() {}
+
+func (x *RdfDatatypeEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfDatatypeEntry.ProtoReflect.Descriptor instead.
+func (*RdfDatatypeEntry) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RdfDatatypeEntry) GetId() uint32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RdfDatatypeEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// RdfStreamOptions must be the first row of the stream.
+type RdfStreamOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamName            string             `protobuf:"bytes,1,opt,name=stream_name,json=streamName,proto3" json:"stream_name,omitempty"`
+	PhysicalType          PhysicalStreamType `protobuf:"varint,2,opt,name=physical_type,json=physicalType,proto3,enum=eu.ostrzyciel.jelly.core.proto.v1.PhysicalStreamType" json:"physical_type,omitempty"`
+	GeneralizedStatements bool               `protobuf:"varint,3,opt,name=generalized_statements,json=generalizedStatements,proto3" json:"generalized_statements,omitempty"`
+	RdfStar               bool               `protobuf:"varint,4,opt,name=rdf_star,json=rdfStar,proto3" json:"rdf_star,omitempty"`
+	MaxNameTableSize      uint32             `protobuf:"varint,9,opt,name=max_name_table_size,json=maxNameTableSize,proto3" json:"max_name_table_size,omitempty"`
+	MaxPrefixTableSize    uint32             `protobuf:"varint,10,opt,name=max_prefix_table_size,json=maxPrefixTableSize,proto3" json:"max_prefix_table_size,omitempty"`
+	MaxDatatypeTableSize  uint32             `protobuf:"varint,11,opt,name=max_datatype_table_size,json=maxDatatypeTableSize,proto3" json:"max_datatype_table_size,omitempty"`
+	LogicalType           LogicalStreamType  `protobuf:"varint,14,opt,name=logical_type,json=logicalType,proto3,enum=eu.ostrzyciel.jelly.core.proto.v1.LogicalStreamType" json:"logical_type,omitempty"`
+	Version               uint32             `protobuf:"varint,15,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *RdfStreamOptions) Reset() {
+	*x = RdfStreamOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfStreamOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfStreamOptions) ProtoMessage() {}
+
+func (x *RdfStreamOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfStreamOptions.ProtoReflect.Descriptor instead.
+func (*RdfStreamOptions) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RdfStreamOptions) GetStreamName() string {
+	if x != nil {
+		return x.StreamName
+	}
+	return ""
+}
+
+func (x *RdfStreamOptions) GetPhysicalType() PhysicalStreamType {
+	if x != nil {
+		return x.PhysicalType
+	}
+	return PhysicalStreamType_PHYSICAL_STREAM_TYPE_UNSPECIFIED
+}
+
+func (x *RdfStreamOptions) GetGeneralizedStatements() bool {
+	if x != nil {
+		return x.GeneralizedStatements
+	}
+	return false
+}
+
+func (x *RdfStreamOptions) GetRdfStar() bool {
+	if x != nil {
+		return x.RdfStar
+	}
+	return false
+}
+
+func (x *RdfStreamOptions) GetMaxNameTableSize() uint32 {
+	if x != nil {
+		return x.MaxNameTableSize
+	}
+	return 0
+}
+
+func (x *RdfStreamOptions) GetMaxPrefixTableSize() uint32 {
+	if x != nil {
+		return x.MaxPrefixTableSize
+	}
+	return 0
+}
+
+func (x *RdfStreamOptions) GetMaxDatatypeTableSize() uint32 {
+	if x != nil {
+		return x.MaxDatatypeTableSize
+	}
+	return 0
+}
+
+func (x *RdfStreamOptions) GetLogicalType() LogicalStreamType {
+	if x != nil {
+		return x.LogicalType
+	}
+	return LogicalStreamType_LOGICAL_STREAM_TYPE_UNSPECIFIED
+}
+
+func (x *RdfStreamOptions) GetVersion() uint32 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+type RdfStreamRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Row:
+	//
+	//	*RdfStreamRow_Options
+	//	*RdfStreamRow_Triple
+	//	*RdfStreamRow_Quad
+	//	*RdfStreamRow_GraphStart
+	//	*RdfStreamRow_GraphEnd
+	//	*RdfStreamRow_Namespace
+	//	*RdfStreamRow_Name
+	//	*RdfStreamRow_Prefix
+	//	*RdfStreamRow_Datatype
+	Row isRdfStreamRow_Row `protobuf_oneof:"row"`
+}
+
+func (x *RdfStreamRow) Reset() {
+	*x = RdfStreamRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfStreamRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfStreamRow) ProtoMessage() {}
+
+func (x *RdfStreamRow) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfStreamRow.ProtoReflect.Descriptor instead.
+func (*RdfStreamRow) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *RdfStreamRow) GetRow() isRdfStreamRow_Row {
+	if m != nil {
+		return m.Row
+	}
+	return nil
+}
+
+func (x *RdfStreamRow) GetOptions() *RdfStreamOptions {
+	if x, ok := x.GetRow().(*RdfStreamRow_Options); ok {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *RdfStreamRow) GetTriple() *RdfTriple {
+	if x, ok := x.GetRow().(*RdfStreamRow_Triple); ok {
+		return x.Triple
+	}
+	return nil
+}
+
+func (x *RdfStreamRow) GetQuad() *RdfQuad {
+	if x, ok := x.GetRow().(*RdfStreamRow_Quad); ok {
+		return x.Quad
+	}
+	return nil
+}
+
+func (x *RdfStreamRow) GetGraphStart() *RdfGraphStart {
+	if x, ok := x.GetRow().(*RdfStreamRow_GraphStart); ok {
+		return x.GraphStart
+	}
+	return nil
+}
+
+func (x *RdfStreamRow) GetGraphEnd() *RdfGraphEnd {
+	if x, ok := x.GetRow().(*RdfStreamRow_GraphEnd); ok {
+		return x.GraphEnd
+	}
+	return nil
+}
+
+func (x *RdfStreamRow) GetNamespace() *RdfNamespaceDeclaration {
+	if x, ok := x.GetRow().(*RdfStreamRow_Namespace); ok {
+		return x.Namespace
+	}
+	return nil
+}
+
+func (x *RdfStreamRow) GetName() *RdfNameEntry {
+	if x, ok := x.GetRow().(*RdfStreamRow_Name); ok {
+		return x.Name
+	}
+	return nil
+}
+
+func (x *RdfStreamRow) GetPrefix() *RdfPrefixEntry {
+	if x, ok := x.GetRow().(*RdfStreamRow_Prefix); ok {
+		return x.Prefix
+	}
+	return nil
+}
+
+func (x *RdfStreamRow) GetDatatype() *RdfDatatypeEntry {
+	if x, ok := x.GetRow().(*RdfStreamRow_Datatype); ok {
+		return x.Datatype
+	}
+	return nil
+}
+
+type isRdfStreamRow_Row interface {
+	isRdfStreamRow_Row()
+}
+
+type RdfStreamRow_Options struct {
+	Options *RdfStreamOptions `protobuf:"bytes,1,opt,name=options,proto3,oneof"`
+}
+
+type RdfStreamRow_Triple struct {
+	Triple *RdfTriple `protobuf:"bytes,2,opt,name=triple,proto3,oneof"`
+}
+
+type RdfStreamRow_Quad struct {
+	Quad *RdfQuad `protobuf:"bytes,3,opt,name=quad,proto3,oneof"`
+}
+
+type RdfStreamRow_GraphStart struct {
+	GraphStart *RdfGraphStart `protobuf:"bytes,4,opt,name=graph_start,json=graphStart,proto3,oneof"`
+}
+
+type RdfStreamRow_GraphEnd struct {
+	GraphEnd *RdfGraphEnd `protobuf:"bytes,5,opt,name=graph_end,json=graphEnd,proto3,oneof"`
+}
+
+type RdfStreamRow_Namespace struct {
+	Namespace *RdfNamespaceDeclaration `protobuf:"bytes,6,opt,name=namespace,proto3,oneof"`
+}
+
+type RdfStreamRow_Name struct {
+	Name *RdfNameEntry `protobuf:"bytes,9,opt,name=name,proto3,oneof"`
+}
+
+type RdfStreamRow_Prefix struct {
+	Prefix *RdfPrefixEntry `protobuf:"bytes,10,opt,name=prefix,proto3,oneof"`
+}
+
+type RdfStreamRow_Datatype struct {
+	Datatype *RdfDatatypeEntry `protobuf:"bytes,11,opt,name=datatype,proto3,oneof"`
+}
+
+func (*RdfStreamRow_Options) isRdfStreamRow_Row() {}
+
+func (*RdfStreamRow_Triple) isRdfStreamRow_Row() {}
+
+func (*RdfStreamRow_Quad) isRdfStreamRow_Row() {}
+
+func (*RdfStreamRow_GraphStart) isRdfStreamRow_Row() {}
+
+func (*RdfStreamRow_GraphEnd) isRdfStreamRow_Row() {}
+
+func (*RdfStreamRow_Namespace) isRdfStreamRow_Row() {}
+
+func (*RdfStreamRow_Name) isRdfStreamRow_Row() {}
+
+func (*RdfStreamRow_Prefix) isRdfStreamRow_Row() {}
+
+func (*RdfStreamRow_Datatype) isRdfStreamRow_Row() {}
+
+// RdfStreamFrame is a batch of rows. Frames are written with a varint length prefix.
+type RdfStreamFrame struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rows []*RdfStreamRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+}
+
+func (x *RdfStreamFrame) Reset() {
+	*x = RdfStreamFrame{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rdf_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RdfStreamFrame) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RdfStreamFrame) ProtoMessage() {}
+
+func (x *RdfStreamFrame) ProtoReflect() protoreflect.Message {
+	mi := &file_rdf_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RdfStreamFrame.ProtoReflect.Descriptor instead.
+func (*RdfStreamFrame) Descriptor() ([]byte, []int) {
+	return file_rdf_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RdfStreamFrame) GetRows() []*RdfStreamRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+var File_rdf_proto protoreflect.FileDescriptor
+
+var file_rdf_proto_rawDesc = []byte{
+	0x0a, 0x09, 0x72, 0x64, 0x66, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x21, 0x65, 0x75, 0x2e,
+	0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x22, 0x3e,
+	0x0a, 0x06, 0x52, 0x64, 0x66, 0x49, 0x72, 0x69, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x66,
+	0x69, 0x78, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x70, 0x72, 0x65,
+	0x66, 0x69, 0x78, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x61, 0x6d, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x61, 0x6d, 0x65, 0x49, 0x64, 0x22, 0x67,
+	0x0a, 0x0a, 0x52, 0x64, 0x66, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x10, 0x0a, 0x03,
+	0x6c, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6c, 0x65, 0x78, 0x12, 0x1a,
+	0x0a, 0x07, 0x6c, 0x61, 0x6e, 0x67, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x07, 0x6c, 0x61, 0x6e, 0x67, 0x74, 0x61, 0x67, 0x12, 0x1c, 0x0a, 0x08, 0x64, 0x61,
+	0x74, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x08,
+	0x64, 0x61, 0x74, 0x61, 0x74, 0x79, 0x70, 0x65, 0x42, 0x0d, 0x0a, 0x0b, 0x6c, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x4b, 0x69, 0x6e, 0x64, 0x22, 0x11, 0x0a, 0x0f, 0x52, 0x64, 0x66, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x22, 0xaa, 0x06, 0x0a, 0x09, 0x52,
+	0x64, 0x66, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x5f, 0x69, 0x72,
+	0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74,
+	0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x49,
+	0x72, 0x69, 0x48, 0x00, 0x52, 0x04, 0x73, 0x49, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x07, 0x73, 0x5f,
+	0x62, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73,
+	0x42, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x73, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73,
+	0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66,
+	0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x73, 0x4c, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x12, 0x52, 0x0a, 0x0d, 0x73, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x5f,
+	0x74, 0x65, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x75, 0x2e,
+	0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79,
+	0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x64, 0x66, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x54, 0x72, 0x69,
+	0x70, 0x6c, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x40, 0x0a, 0x05, 0x70, 0x5f, 0x69, 0x72, 0x69,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72,
+	0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x49, 0x72,
+	0x69, 0x48, 0x01, 0x52, 0x04, 0x70, 0x49, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x07, 0x70, 0x5f, 0x62,
+	0x6e, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x70, 0x42,
+	0x6e, 0x6f, 0x64, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x70, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74,
+	0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x4c,
+	0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x48, 0x01, 0x52, 0x08, 0x70, 0x4c, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x12, 0x52, 0x0a, 0x0d, 0x70, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x74,
+	0x65, 0x72, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x75, 0x2e, 0x6f,
+	0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64,
+	0x66, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x54, 0x72, 0x69, 0x70,
+	0x6c, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x40, 0x0a, 0x05, 0x6f, 0x5f, 0x69, 0x72, 0x69, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a,
+	0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x49, 0x72, 0x69,
+	0x48, 0x02, 0x52, 0x04, 0x6f, 0x49, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x07, 0x6f, 0x5f, 0x62, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x42, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x6f, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72,
+	0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x4c, 0x69,
+	0x74, 0x65, 0x72, 0x61, 0x6c, 0x48, 0x02, 0x52, 0x08, 0x6f, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x12, 0x52, 0x0a, 0x0d, 0x6f, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x74, 0x65,
+	0x72, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73,
+	0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63,
+	0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66,
+	0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x48, 0x02, 0x52, 0x0b, 0x6f, 0x54, 0x72, 0x69, 0x70, 0x6c,
+	0x65, 0x54, 0x65, 0x72, 0x6d, 0x42, 0x09, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x42, 0x0b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x42, 0x08, 0x0a,
+	0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x22, 0xba, 0x08, 0x0a, 0x07, 0x52, 0x64, 0x66, 0x51,
+	0x75, 0x61, 0x64, 0x12, 0x40, 0x0a, 0x05, 0x73, 0x5f, 0x69, 0x72, 0x69, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69,
+	0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x49, 0x72, 0x69, 0x48, 0x00, 0x52,
+	0x04, 0x73, 0x49, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x07, 0x73, 0x5f, 0x62, 0x6e, 0x6f, 0x64, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x73, 0x42, 0x6e, 0x6f, 0x64, 0x65,
+	0x12, 0x4c, 0x0a, 0x09, 0x73, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63,
+	0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x4c, 0x69, 0x74, 0x65, 0x72,
+	0x61, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x73, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x52,
+	0x0a, 0x0d, 0x73, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a,
+	0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x54, 0x72, 0x69,
+	0x70, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x73, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x54, 0x65,
+	0x72, 0x6d, 0x12, 0x40, 0x0a, 0x05, 0x70, 0x5f, 0x69, 0x72, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x29, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65,
+	0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x49, 0x72, 0x69, 0x48, 0x01, 0x52, 0x04,
+	0x70, 0x49, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x07, 0x70, 0x5f, 0x62, 0x6e, 0x6f, 0x64, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x06, 0x70, 0x42, 0x6e, 0x6f, 0x64, 0x65, 0x12,
+	0x4c, 0x0a, 0x09, 0x70, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69,
+	0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61,
+	0x6c, 0x48, 0x01, 0x52, 0x08, 0x70, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x52, 0x0a,
+	0x0d, 0x70, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79,
+	0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x54, 0x72, 0x69, 0x70,
+	0x6c, 0x65, 0x48, 0x01, 0x52, 0x0b, 0x70, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x54, 0x65, 0x72,
+	0x6d, 0x12, 0x40, 0x0a, 0x05, 0x6f, 0x5f, 0x69, 0x72, 0x69, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c,
+	0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x49, 0x72, 0x69, 0x48, 0x02, 0x52, 0x04, 0x6f,
+	0x49, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x07, 0x6f, 0x5f, 0x62, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x06, 0x6f, 0x42, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x4c,
+	0x0a, 0x09, 0x6f, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65,
+	0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c,
+	0x48, 0x02, 0x52, 0x08, 0x6f, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x12, 0x52, 0x0a, 0x0d,
+	0x6f, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x74, 0x65, 0x72, 0x6d, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63,
+	0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x54, 0x72, 0x69, 0x70, 0x6c,
+	0x65, 0x48, 0x02, 0x52, 0x0b, 0x6f, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x54, 0x65, 0x72, 0x6d,
+	0x12, 0x40, 0x0a, 0x05, 0x67, 0x5f, 0x69, 0x72, 0x69, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e,
+	0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x49, 0x72, 0x69, 0x48, 0x03, 0x52, 0x04, 0x67, 0x49,
+	0x72, 0x69, 0x12, 0x19, 0x0a, 0x07, 0x67, 0x5f, 0x62, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x0e, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x06, 0x67, 0x42, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x5c, 0x0a,
+	0x0f, 0x67, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x67, 0x72, 0x61, 0x70, 0x68,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72,
+	0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x44, 0x65,
+	0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x48, 0x03, 0x52, 0x0d, 0x67, 0x44,
+	0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68, 0x12, 0x4c, 0x0a, 0x09, 0x67,
+	0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a,
+	0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x48, 0x03, 0x52,
+	0x08, 0x67, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x42, 0x09, 0x0a, 0x07, 0x73, 0x75, 0x62,
+	0x6a, 0x65, 0x63, 0x74, 0x42, 0x0b, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x22, 0xa1, 0x02, 0x0a, 0x0d, 0x52, 0x64, 0x66, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x40, 0x0a, 0x05, 0x67, 0x5f, 0x69, 0x72, 0x69, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a,
+	0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x49, 0x72, 0x69,
+	0x48, 0x00, 0x52, 0x04, 0x67, 0x49, 0x72, 0x69, 0x12, 0x19, 0x0a, 0x07, 0x67, 0x5f, 0x62, 0x6e,
+	0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x06, 0x67, 0x42, 0x6e,
+	0x6f, 0x64, 0x65, 0x12, 0x5c, 0x0a, 0x0f, 0x67, 0x5f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x67, 0x72, 0x61, 0x70, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x65,
+	0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c,
+	0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x52, 0x64, 0x66, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x48, 0x00, 0x52, 0x0d, 0x67, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x61, 0x70,
+	0x68, 0x12, 0x4c, 0x0a, 0x09, 0x67, 0x5f, 0x6c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79,
+	0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x4c, 0x69, 0x74, 0x65,
+	0x72, 0x61, 0x6c, 0x48, 0x00, 0x52, 0x08, 0x67, 0x4c, 0x69, 0x74, 0x65, 0x72, 0x61, 0x6c, 0x42,
+	0x07, 0x0a, 0x05, 0x67, 0x72, 0x61, 0x70, 0x68, 0x22, 0x0d, 0x0a, 0x0b, 0x52, 0x64, 0x66, 0x47,
+	0x72, 0x61, 0x70, 0x68, 0x45, 0x6e, 0x64, 0x22, 0x6e, 0x0a, 0x17, 0x52, 0x64, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3f, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a,
+	0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x49, 0x72, 0x69,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x34, 0x0a, 0x0c, 0x52, 0x64, 0x66, 0x4e, 0x61,
+	0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x36, 0x0a,
+	0x0e, 0x52, 0x64, 0x66, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x38, 0x0a, 0x10, 0x52, 0x64, 0x66, 0x44, 0x61, 0x74, 0x61,
+	0x74, 0x79, 0x70, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22,
+	0xed, 0x03, 0x0a, 0x10, 0x52, 0x64, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x35, 0x2e, 0x65,
+	0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c,
+	0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31,
+	0x2e, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x54,
+	0x79, 0x70, 0x65, 0x52, 0x0c, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x35, 0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64,
+	0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x15, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x64, 0x66, 0x5f,
+	0x73, 0x74, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x64, 0x66, 0x53,
+	0x74, 0x61, 0x72, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x5f,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x10, 0x6d, 0x61, 0x78, 0x4e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x69,
+	0x7a, 0x65, 0x12, 0x31, 0x0a, 0x15, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x35, 0x0a, 0x17, 0x6d, 0x61, 0x78, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x44, 0x61, 0x74, 0x61, 0x74,
+	0x79, 0x70, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x57, 0x0a, 0x0c,
+	0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x34, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69,
+	0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61,
+	0x6c, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22,
+	0xd7, 0x05, 0x0a, 0x0c, 0x52, 0x64, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x6f, 0x77,
+	0x12, 0x4f, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65,
+	0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x48, 0x00, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x46, 0x0a, 0x06, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65,
+	0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x48,
+	0x00, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x40, 0x0a, 0x04, 0x71, 0x75, 0x61,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74,
+	0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x51,
+	0x75, 0x61, 0x64, 0x48, 0x00, 0x52, 0x04, 0x71, 0x75, 0x61, 0x64, 0x12, 0x53, 0x0a, 0x0b, 0x67,
+	0x72, 0x61, 0x70, 0x68, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x30, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c,
+	0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x47, 0x72, 0x61, 0x70, 0x68, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x48, 0x00, 0x52, 0x0a, 0x67, 0x72, 0x61, 0x70, 0x68, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x4d, 0x0a, 0x09, 0x67, 0x72, 0x61, 0x70, 0x68, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63,
+	0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x47, 0x72, 0x61, 0x70, 0x68,
+	0x45, 0x6e, 0x64, 0x48, 0x00, 0x52, 0x08, 0x67, 0x72, 0x61, 0x70, 0x68, 0x45, 0x6e, 0x64, 0x12,
+	0x5a, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x3a, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69,
+	0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x44, 0x65, 0x63, 0x6c, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00,
+	0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x45, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x75, 0x2e, 0x6f,
+	0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64,
+	0x66, 0x4e, 0x61, 0x6d, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x4b, 0x0a, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x31, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69,
+	0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x06, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x12,
+	0x51, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x33, 0x2e, 0x65, 0x75, 0x2e, 0x6f, 0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65,
+	0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e, 0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64, 0x66, 0x44, 0x61, 0x74, 0x61, 0x74, 0x79, 0x70,
+	0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x48, 0x00, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x74, 0x79,
+	0x70, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x72, 0x6f, 0x77, 0x22, 0x55, 0x0a, 0x0e, 0x52, 0x64, 0x66,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x04, 0x72,
+	0x6f, 0x77, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x65, 0x75, 0x2e, 0x6f,
+	0x73, 0x74, 0x72, 0x7a, 0x79, 0x63, 0x69, 0x65, 0x6c, 0x2e, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x2e,
+	0x63, 0x6f, 0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x64,
+	0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x2a, 0x9d, 0x01, 0x0a, 0x12, 0x50, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a, 0x20, 0x50, 0x48, 0x59, 0x53, 0x49,
+	0x43, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x20, 0x0a,
+	0x1c, 0x50, 0x48, 0x59, 0x53, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x52, 0x49, 0x50, 0x4c, 0x45, 0x53, 0x10, 0x01, 0x12,
+	0x1e, 0x0a, 0x1a, 0x50, 0x48, 0x59, 0x53, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x52, 0x45,
+	0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x51, 0x55, 0x41, 0x44, 0x53, 0x10, 0x02, 0x12,
+	0x1f, 0x0a, 0x1b, 0x50, 0x48, 0x59, 0x53, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x52, 0x45,
+	0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x41, 0x50, 0x48, 0x53, 0x10, 0x03,
+	0x2a, 0xc4, 0x01, 0x0a, 0x11, 0x4c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41,
+	0x4c, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x4c,
+	0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x46, 0x4c, 0x41, 0x54, 0x5f, 0x54, 0x52, 0x49, 0x50, 0x4c, 0x45, 0x53, 0x10,
+	0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c, 0x5f, 0x53, 0x54, 0x52,
+	0x45, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x46, 0x4c, 0x41, 0x54, 0x5f, 0x51, 0x55,
+	0x41, 0x44, 0x53, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x47, 0x52, 0x41,
+	0x50, 0x48, 0x53, 0x10, 0x03, 0x12, 0x20, 0x0a, 0x1c, 0x4c, 0x4f, 0x47, 0x49, 0x43, 0x41, 0x4c,
+	0x5f, 0x53, 0x54, 0x52, 0x45, 0x41, 0x4d, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x41, 0x54,
+	0x41, 0x53, 0x45, 0x54, 0x53, 0x10, 0x04, 0x42, 0x23, 0x5a, 0x21, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x79, 0x6c, 0x65, 0x79, 0x67, 0x72, 0x61, 0x70,
+	0x68, 0x2f, 0x71, 0x75, 0x61, 0x64, 0x2f, 0x6a, 0x65, 0x6c, 0x6c, 0x79, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_rdf_proto_rawDescOnce sync.Once
+	file_rdf_proto_rawDescData = file_rdf_proto_rawDesc
+)
+
+func file_rdf_proto_rawDescGZIP() []byte {
+	file_rdf_proto_rawDescOnce.Do(func() {
+		file_rdf_proto_rawDescData = protoimpl.X.CompressGZIP(file_rdf_proto_rawDescData)
+	})
+	return file_rdf_proto_rawDescData
+}
+
+var file_rdf_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_rdf_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_rdf_proto_goTypes = []any{
+	(PhysicalStreamType)(0),         // 0: eu.ostrzyciel.jelly.core.proto.v1.PhysicalStreamType
+	(LogicalStreamType)(0),          // 1: eu.ostrzyciel.jelly.core.proto.v1.LogicalStreamType
+	(*RdfIri)(nil),                  // 2: eu.ostrzyciel.jelly.core.proto.v1.RdfIri
+	(*RdfLiteral)(nil),              // 3: eu.ostrzyciel.jelly.core.proto.v1.RdfLiteral
+	(*RdfDefaultGraph)(nil),         // 4: eu.ostrzyciel.jelly.core.proto.v1.RdfDefaultGraph
+	(*RdfTriple)(nil),               // 5: eu.ostrzyciel.jelly.core.proto.v1.RdfTriple
+	(*RdfQuad)(nil),                 // 6: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad
+	(*RdfGraphStart)(nil),           // 7: eu.ostrzyciel.jelly.core.proto.v1.RdfGraphStart
+	(*RdfGraphEnd)(nil),             // 8: eu.ostrzyciel.jelly.core.proto.v1.RdfGraphEnd
+	(*RdfNamespaceDeclaration)(nil), // 9: eu.ostrzyciel.jelly.core.proto.v1.RdfNamespaceDeclaration
+	(*RdfNameEntry)(nil),            // 10: eu.ostrzyciel.jelly.core.proto.v1.RdfNameEntry
+	(*RdfPrefixEntry)(nil),          // 11: eu.ostrzyciel.jelly.core.proto.v1.RdfPrefixEntry
+	(*RdfDatatypeEntry)(nil),        // 12: eu.ostrzyciel.jelly.core.proto.v1.RdfDatatypeEntry
+	(*RdfStreamOptions)(nil),        // 13: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamOptions
+	(*RdfStreamRow)(nil),            // 14: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow
+	(*RdfStreamFrame)(nil),          // 15: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamFrame
+}
+var file_rdf_proto_depIdxs = []int32{
+	2,  // 0: eu.ostrzyciel.jelly.core.proto.v1.RdfTriple.s_iri:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfIri
+	3,  // 1: eu.ostrzyciel.jelly.core.proto.v1.RdfTriple.s_literal:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfLiteral
+	5,  // 2: eu.ostrzyciel.jelly.core.proto.v1.RdfTriple.s_triple_term:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfTriple
+	2,  // 3: eu.ostrzyciel.jelly.core.proto.v1.RdfTriple.p_iri:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfIri
+	3,  // 4: eu.ostrzyciel.jelly.core.proto.v1.RdfTriple.p_literal:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfLiteral
+	5,  // 5: eu.ostrzyciel.jelly.core.proto.v1.RdfTriple.p_triple_term:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfTriple
+	2,  // 6: eu.ostrzyciel.jelly.core.proto.v1.RdfTriple.o_iri:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfIri
+	3,  // 7: eu.ostrzyciel.jelly.core.proto.v1.RdfTriple.o_literal:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfLiteral
+	5,  // 8: eu.ostrzyciel.jelly.core.proto.v1.RdfTriple.o_triple_term:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfTriple
+	2,  // 9: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.s_iri:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfIri
+	3,  // 10: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.s_literal:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfLiteral
+	5,  // 11: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.s_triple_term:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfTriple
+	2,  // 12: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.p_iri:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfIri
+	3,  // 13: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.p_literal:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfLiteral
+	5,  // 14: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.p_triple_term:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfTriple
+	2,  // 15: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.o_iri:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfIri
+	3,  // 16: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.o_literal:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfLiteral
+	5,  // 17: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.o_triple_term:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfTriple
+	2,  // 18: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.g_iri:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfIri
+	4,  // 19: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.g_default_graph:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfDefaultGraph
+	3,  // 20: eu.ostrzyciel.jelly.core.proto.v1.RdfQuad.g_literal:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfLiteral
+	2,  // 21: eu.ostrzyciel.jelly.core.proto.v1.RdfGraphStart.g_iri:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfIri
+	4,  // 22: eu.ostrzyciel.jelly.core.proto.v1.RdfGraphStart.g_default_graph:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfDefaultGraph
+	3,  // 23: eu.ostrzyciel.jelly.core.proto.v1.RdfGraphStart.g_literal:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfLiteral
+	2,  // 24: eu.ostrzyciel.jelly.core.proto.v1.RdfNamespaceDeclaration.value:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfIri
+	0,  // 25: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamOptions.physical_type:type_name -> eu.ostrzyciel.jelly.core.proto.v1.PhysicalStreamType
+	1,  // 26: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamOptions.logical_type:type_name -> eu.ostrzyciel.jelly.core.proto.v1.LogicalStreamType
+	13, // 27: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow.options:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfStreamOptions
+	5,  // 28: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow.triple:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfTriple
+	6,  // 29: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow.quad:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfQuad
+	7,  // 30: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow.graph_start:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfGraphStart
+	8,  // 31: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow.graph_end:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfGraphEnd
+	9,  // 32: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow.namespace:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfNamespaceDeclaration
+	10, // 33: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow.name:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfNameEntry
+	11, // 34: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow.prefix:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfPrefixEntry
+	12, // 35: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow.datatype:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfDatatypeEntry
+	14, // 36: eu.ostrzyciel.jelly.core.proto.v1.RdfStreamFrame.rows:type_name -> eu.ostrzyciel.jelly.core.proto.v1.RdfStreamRow
+	37, // [37:37] is the sub-list for method output_type
+	37, // [37:37] is the sub-list for method input_type
+	37, // [37:37] is the sub-list for extension type_name
+	37, // [37:37] is the sub-list for extension extendee
+	0,  // [0:37] is the sub-list for field type_name
+}
+
+func init() { file_rdf_proto_init() }
+func file_rdf_proto_init() {
+	if File_rdf_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rdf_proto_msgTypes[0].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfIri); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfLiteral); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfDefaultGraph); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfTriple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfQuad); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfGraphStart); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfGraphEnd); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfNamespaceDeclaration); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfNameEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfPrefixEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfDatatypeEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfStreamOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfStreamRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rdf_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*RdfStreamFrame); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_rdf_proto_msgTypes[1].OneofWrappers = []any{
+		(*RdfLiteral_Langtag)(nil),
+		(*RdfLiteral_Datatype)(nil),
+	}
+	file_rdf_proto_msgTypes[3].OneofWrappers = []any{
+		(*RdfTriple_SIri)(nil),
+		(*RdfTriple_SBnode)(nil),
+		(*RdfTriple_SLiteral)(nil),
+		(*RdfTriple_STripleTerm)(nil),
+		(*RdfTriple_PIri)(nil),
+		(*RdfTriple_PBnode)(nil),
+		(*RdfTriple_PLiteral)(nil),
+		(*RdfTriple_PTripleTerm)(nil),
+		(*RdfTriple_OIri)(nil),
+		(*RdfTriple_OBnode)(nil),
+		(*RdfTriple_OLiteral)(nil),
+		(*RdfTriple_OTripleTerm)(nil),
+	}
+	file_rdf_proto_msgTypes[4].OneofWrappers = []any{
+		(*RdfQuad_SIri)(nil),
+		(*RdfQuad_SBnode)(nil),
+		(*RdfQuad_SLiteral)(nil),
+		(*RdfQuad_STripleTerm)(nil),
+		(*RdfQuad_PIri)(nil),
+		(*RdfQuad_PBnode)(nil),
+		(*RdfQuad_PLiteral)(nil),
+		(*RdfQuad_PTripleTerm)(nil),
+		(*RdfQuad_OIri)(nil),
+		(*RdfQuad_OBnode)(nil),
+		(*RdfQuad_OLiteral)(nil),
+		(*RdfQuad_OTripleTerm)(nil),
+		(*RdfQuad_GIri)(nil),
+		(*RdfQuad_GBnode)(nil),
+		(*RdfQuad_GDefaultGraph)(nil),
+		(*RdfQuad_GLiteral)(nil),
+	}
+	file_rdf_proto_msgTypes[5].OneofWrappers = []any{
+		(*RdfGraphStart_GIri)(nil),
+		(*RdfGraphStart_GBnode)(nil),
+		(*RdfGraphStart_GDefaultGraph)(nil),
+		(*RdfGraphStart_GLiteral)(nil),
+	}
+	file_rdf_proto_msgTypes[12].OneofWrappers = []any{
+		(*RdfStreamRow_Options)(nil),
+		(*RdfStreamRow_Triple)(nil),
+		(*RdfStreamRow_Quad)(nil),
+		(*RdfStreamRow_GraphStart)(nil),
+		(*RdfStreamRow_GraphEnd)(nil),
+		(*RdfStreamRow_Namespace)(nil),
+		(*RdfStreamRow_Name)(nil),
+		(*RdfStreamRow_Prefix)(nil),
+		(*RdfStreamRow_Datatype)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_rdf_proto_rawDesc,
+			NumEnums:      2,
+			NumMessages:   14,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_rdf_proto_goTypes,
+		DependencyIndexes: file_rdf_proto_depIdxs,
+		EnumInfos:         file_rdf_proto_enumTypes,
+		MessageInfos:      file_rdf_proto_msgTypes,
+	}.Build()
+	File_rdf_proto = out.File
+	file_rdf_proto_rawDesc = nil
+	file_rdf_proto_goTypes = nil
+	file_rdf_proto_depIdxs = nil
+}
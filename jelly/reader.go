@@ -0,0 +1,272 @@
+package jelly
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/pquads/pio"
+)
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+// Reader implements Jelly stream decoder. It supports triples, quads and graphs streams.
+type Reader struct {
+	pr   pio.Reader
+	err  error
+	opts *RdfStreamOptions
+
+	rows []*RdfStreamRow
+	cur  int
+
+	names, prefixes, datatypes *table
+	lastPrefix, lastName       uint32
+
+	s, p, o, g quad.Value
+	graph      quad.Value // current graph in a graphs stream
+}
+
+// NewReader creates Jelly stream decoder. maxSize limits the size of a single stream frame.
+func NewReader(r io.Reader, maxSize int) *Reader {
+	if maxSize <= 0 {
+		maxSize = DefaultMaxSize
+	}
+	return &Reader{pr: pio.NewReader(r, maxSize)}
+}
+
+// Options returns stream options. It returns nil if options were not read yet.
+func (r *Reader) Options() *RdfStreamOptions {
+	return r.opts
+}
+
+// next returns the next row of the stream, reading a new frame if necessary.
+func (r *Reader) next() (*RdfStreamRow, error) {
+	for r.cur >= len(r.rows) {
+		var f RdfStreamFrame
+		if err := r.pr.ReadMsg(&f); err != nil {
+			return nil, err
+		}
+		r.rows, r.cur = f.Rows, 0
+	}
+	row := r.rows[r.cur]
+	r.cur++
+	return row, nil
+}
+
+// setOptions validates stream options and allocates lookup tables.
+func (r *Reader) setOptions(o *RdfStreamOptions) error {
+	if r.opts != nil {
+		return nil // options can be repeated when streams are concatenated
+	}
+	switch o.PhysicalType {
+	case PhysicalStreamType_PHYSICAL_STREAM_TYPE_TRIPLES,
+		PhysicalStreamType_PHYSICAL_STREAM_TYPE_QUADS,
+		PhysicalStreamType_PHYSICAL_STREAM_TYPE_GRAPHS:
+	default:
+		return fmt.Errorf("unsupported stream type: %v", o.PhysicalType)
+	}
+	if o.Version > maxVersion {
+		return fmt.Errorf("unsupported jelly version: %d", o.Version)
+	}
+	r.opts = o
+	r.names = newTable("name", o.MaxNameTableSize)
+	r.prefixes = newTable("prefix", o.MaxPrefixTableSize)
+	r.datatypes = newTable("datatype", o.MaxDatatypeTableSize)
+	return nil
+}
+
+// ReadQuad implements quad.Reader.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	if r.err != nil {
+		return quad.Quad{}, r.err
+	}
+	for {
+		row, err := r.next()
+		if err != nil {
+			r.err = err
+			return quad.Quad{}, err
+		}
+		if o, ok := row.Row.(*RdfStreamRow_Options); ok {
+			if r.err = r.setOptions(o.Options); r.err != nil {
+				return quad.Quad{}, r.err
+			}
+			continue
+		} else if r.opts == nil {
+			r.err = fmt.Errorf("stream options expected")
+			return quad.Quad{}, r.err
+		}
+		var q quad.Quad
+		switch row := row.Row.(type) {
+		case *RdfStreamRow_Name:
+			r.err = r.names.put(row.Name.Id, row.Name.Value)
+		case *RdfStreamRow_Prefix:
+			r.err = r.prefixes.put(row.Prefix.Id, row.Prefix.Value)
+		case *RdfStreamRow_Datatype:
+			r.err = r.datatypes.put(row.Datatype.Id, row.Datatype.Value)
+		case *RdfStreamRow_Namespace:
+			// namespace declarations carry no data
+		case *RdfStreamRow_GraphStart:
+			t, ok := termOf(row.GraphStart.Graph)
+			if !ok {
+				r.err = fmt.Errorf("graph is not set")
+				break
+			}
+			r.graph, r.err = r.value(t)
+		case *RdfStreamRow_GraphEnd:
+			r.graph = nil
+		case *RdfStreamRow_Triple:
+			q, r.err = r.triple(row.Triple)
+			q.Label = r.graph
+		case *RdfStreamRow_Quad:
+			q, r.err = r.quad(row.Quad)
+		default:
+			r.err = fmt.Errorf("unexpected row: %T", row)
+		}
+		if r.err != nil {
+			return quad.Quad{}, r.err
+		} else if q.Subject != nil {
+			return q, nil
+		}
+	}
+}
+
+// ReadQuads implements quad.BatchReader.
+func (r *Reader) ReadQuads(buf []quad.Quad) (int, error) {
+	for i := range buf {
+		q, err := r.ReadQuad()
+		if err != nil {
+			return i, err
+		}
+		buf[i] = q
+	}
+	return len(buf), nil
+}
+
+// term decodes a statement term. If the term is not set, the previous value is returned.
+func (r *Reader) term(v interface{}, prev quad.Value, name string) (quad.Value, error) {
+	t, ok := termOf(v)
+	if !ok {
+		if prev == nil {
+			return nil, fmt.Errorf("%s is not set", name)
+		}
+		return prev, nil
+	}
+	return r.value(t)
+}
+
+func (r *Reader) triple(t *RdfTriple) (q quad.Quad, err error) {
+	if q.Subject, err = r.term(t.Subject, r.s, "subject"); err != nil {
+		return
+	}
+	if q.Predicate, err = r.term(t.Predicate, r.p, "predicate"); err != nil {
+		return
+	}
+	if q.Object, err = r.term(t.Object, r.o, "object"); err != nil {
+		return
+	}
+	r.s, r.p, r.o = q.Subject, q.Predicate, q.Object
+	return q, nil
+}
+
+func (r *Reader) quad(t *RdfQuad) (q quad.Quad, err error) {
+	if q.Subject, err = r.term(t.Subject, r.s, "subject"); err != nil {
+		return
+	}
+	if q.Predicate, err = r.term(t.Predicate, r.p, "predicate"); err != nil {
+		return
+	}
+	if q.Object, err = r.term(t.Object, r.o, "object"); err != nil {
+		return
+	}
+	if g, ok := termOf(t.Graph); ok {
+		if q.Label, err = r.value(g); err != nil {
+			return
+		}
+	} else if r.s == nil {
+		return q, fmt.Errorf("graph is not set")
+	} else {
+		q.Label = r.g
+	}
+	r.s, r.p, r.o, r.g = q.Subject, q.Predicate, q.Object, q.Label
+	return q, nil
+}
+
+// quoted decodes a quoted triple. All terms of a quoted triple must be set.
+func (r *Reader) quoted(t *RdfTriple) (quad.Value, error) {
+	var (
+		out quad.Triple
+		err error
+	)
+	if out.Subject, err = r.term(t.Subject, nil, "quoted subject"); err != nil {
+		return nil, err
+	}
+	if out.Predicate, err = r.term(t.Predicate, nil, "quoted predicate"); err != nil {
+		return nil, err
+	}
+	if out.Object, err = r.term(t.Object, nil, "quoted object"); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (r *Reader) value(t term) (quad.Value, error) {
+	switch {
+	case t.def:
+		return nil, nil
+	case t.iri != nil:
+		return r.iri(t.iri)
+	case t.lit != nil:
+		return r.literal(t.lit)
+	case t.triple != nil:
+		return r.quoted(t.triple)
+	}
+	return quad.BNode(t.bnode), nil
+}
+
+func (r *Reader) iri(v *RdfIri) (quad.Value, error) {
+	pid, nid := v.PrefixId, v.NameId
+	if pid == 0 {
+		pid = r.lastPrefix
+	}
+	if nid == 0 {
+		nid = r.lastName + 1
+	}
+	var pref string
+	if r.opts.MaxPrefixTableSize != 0 {
+		var err error
+		if pref, err = r.prefixes.get(pid); err != nil {
+			return nil, err
+		}
+	}
+	name, err := r.names.get(nid)
+	if err != nil {
+		return nil, err
+	}
+	r.lastPrefix, r.lastName = pid, nid
+	return quad.IRI(pref + name), nil
+}
+
+func (r *Reader) literal(v *RdfLiteral) (quad.Value, error) {
+	switch k := v.LiteralKind.(type) {
+	case *RdfLiteral_Langtag:
+		return quad.LangString{Value: quad.String(v.Lex), Lang: k.Langtag}, nil
+	case *RdfLiteral_Datatype:
+		dt, err := r.datatypes.get(k.Datatype)
+		if err != nil {
+			return nil, err
+		}
+		ts := quad.TypedString{Value: quad.String(v.Lex), Type: quad.IRI(dt)}
+		if AutoConvertTypedString {
+			if nv, err := ts.ParseValue(); err == nil {
+				return nv, nil
+			}
+		}
+		return ts, nil
+	}
+	return quad.String(v.Lex), nil
+}
+
+// Close implements quad.Reader.
+func (r *Reader) Close() error {
+	return nil
+}
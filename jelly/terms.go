@@ -0,0 +1,170 @@
+package jelly
+
+// term is a single RDF term in its protobuf representation.
+//
+// At most one of iri, lit and triple is set. If none of them is set, the term is a blank node,
+// or a default graph if def is set.
+type term struct {
+	iri    *RdfIri
+	bnode  string
+	lit    *RdfLiteral
+	triple *RdfTriple
+	def    bool
+}
+
+func (t term) tripleSubject() isRdfTriple_Subject {
+	switch {
+	case t.iri != nil:
+		return &RdfTriple_SIri{SIri: t.iri}
+	case t.lit != nil:
+		return &RdfTriple_SLiteral{SLiteral: t.lit}
+	case t.triple != nil:
+		return &RdfTriple_STripleTerm{STripleTerm: t.triple}
+	}
+	return &RdfTriple_SBnode{SBnode: t.bnode}
+}
+
+func (t term) triplePredicate() isRdfTriple_Predicate {
+	switch {
+	case t.iri != nil:
+		return &RdfTriple_PIri{PIri: t.iri}
+	case t.lit != nil:
+		return &RdfTriple_PLiteral{PLiteral: t.lit}
+	case t.triple != nil:
+		return &RdfTriple_PTripleTerm{PTripleTerm: t.triple}
+	}
+	return &RdfTriple_PBnode{PBnode: t.bnode}
+}
+
+func (t term) tripleObject() isRdfTriple_Object {
+	switch {
+	case t.iri != nil:
+		return &RdfTriple_OIri{OIri: t.iri}
+	case t.lit != nil:
+		return &RdfTriple_OLiteral{OLiteral: t.lit}
+	case t.triple != nil:
+		return &RdfTriple_OTripleTerm{OTripleTerm: t.triple}
+	}
+	return &RdfTriple_OBnode{OBnode: t.bnode}
+}
+
+func (t term) quadSubject() isRdfQuad_Subject {
+	switch {
+	case t.iri != nil:
+		return &RdfQuad_SIri{SIri: t.iri}
+	case t.lit != nil:
+		return &RdfQuad_SLiteral{SLiteral: t.lit}
+	case t.triple != nil:
+		return &RdfQuad_STripleTerm{STripleTerm: t.triple}
+	}
+	return &RdfQuad_SBnode{SBnode: t.bnode}
+}
+
+func (t term) quadPredicate() isRdfQuad_Predicate {
+	switch {
+	case t.iri != nil:
+		return &RdfQuad_PIri{PIri: t.iri}
+	case t.lit != nil:
+		return &RdfQuad_PLiteral{PLiteral: t.lit}
+	case t.triple != nil:
+		return &RdfQuad_PTripleTerm{PTripleTerm: t.triple}
+	}
+	return &RdfQuad_PBnode{PBnode: t.bnode}
+}
+
+func (t term) quadObject() isRdfQuad_Object {
+	switch {
+	case t.iri != nil:
+		return &RdfQuad_OIri{OIri: t.iri}
+	case t.lit != nil:
+		return &RdfQuad_OLiteral{OLiteral: t.lit}
+	case t.triple != nil:
+		return &RdfQuad_OTripleTerm{OTripleTerm: t.triple}
+	}
+	return &RdfQuad_OBnode{OBnode: t.bnode}
+}
+
+func (t term) quadGraph() isRdfQuad_Graph {
+	switch {
+	case t.def:
+		return &RdfQuad_GDefaultGraph{GDefaultGraph: &RdfDefaultGraph{}}
+	case t.iri != nil:
+		return &RdfQuad_GIri{GIri: t.iri}
+	case t.lit != nil:
+		return &RdfQuad_GLiteral{GLiteral: t.lit}
+	}
+	return &RdfQuad_GBnode{GBnode: t.bnode}
+}
+
+// termOf returns a term from any oneof field of a statement. It returns false if the field is not set.
+func termOf(v interface{}) (term, bool) {
+	switch v := v.(type) {
+	case *RdfTriple_SIri:
+		return term{iri: v.SIri}, true
+	case *RdfTriple_SBnode:
+		return term{bnode: v.SBnode}, true
+	case *RdfTriple_SLiteral:
+		return term{lit: v.SLiteral}, true
+	case *RdfTriple_STripleTerm:
+		return term{triple: v.STripleTerm}, true
+	case *RdfTriple_PIri:
+		return term{iri: v.PIri}, true
+	case *RdfTriple_PBnode:
+		return term{bnode: v.PBnode}, true
+	case *RdfTriple_PLiteral:
+		return term{lit: v.PLiteral}, true
+	case *RdfTriple_PTripleTerm:
+		return term{triple: v.PTripleTerm}, true
+	case *RdfTriple_OIri:
+		return term{iri: v.OIri}, true
+	case *RdfTriple_OBnode:
+		return term{bnode: v.OBnode}, true
+	case *RdfTriple_OLiteral:
+		return term{lit: v.OLiteral}, true
+	case *RdfTriple_OTripleTerm:
+		return term{triple: v.OTripleTerm}, true
+
+	case *RdfQuad_SIri:
+		return term{iri: v.SIri}, true
+	case *RdfQuad_SBnode:
+		return term{bnode: v.SBnode}, true
+	case *RdfQuad_SLiteral:
+		return term{lit: v.SLiteral}, true
+	case *RdfQuad_STripleTerm:
+		return term{triple: v.STripleTerm}, true
+	case *RdfQuad_PIri:
+		return term{iri: v.PIri}, true
+	case *RdfQuad_PBnode:
+		return term{bnode: v.PBnode}, true
+	case *RdfQuad_PLiteral:
+		return term{lit: v.PLiteral}, true
+	case *RdfQuad_PTripleTerm:
+		return term{triple: v.PTripleTerm}, true
+	case *RdfQuad_OIri:
+		return term{iri: v.OIri}, true
+	case *RdfQuad_OBnode:
+		return term{bnode: v.OBnode}, true
+	case *RdfQuad_OLiteral:
+		return term{lit: v.OLiteral}, true
+	case *RdfQuad_OTripleTerm:
+		return term{triple: v.OTripleTerm}, true
+	case *RdfQuad_GIri:
+		return term{iri: v.GIri}, true
+	case *RdfQuad_GBnode:
+		return term{bnode: v.GBnode}, true
+	case *RdfQuad_GLiteral:
+		return term{lit: v.GLiteral}, true
+	case *RdfQuad_GDefaultGraph:
+		return term{def: true}, true
+
+	case *RdfGraphStart_GIri:
+		return term{iri: v.GIri}, true
+	case *RdfGraphStart_GBnode:
+		return term{bnode: v.GBnode}, true
+	case *RdfGraphStart_GLiteral:
+		return term{lit: v.GLiteral}, true
+	case *RdfGraphStart_GDefaultGraph:
+		return term{def: true}, true
+	}
+	return term{}, false
+}
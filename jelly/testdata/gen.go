@@ -0,0 +1,174 @@
+//go:build ignore
+
+// This program writes example.jelly, a delimited Jelly stream with two frames, following
+// the Jelly RDF serialization specification. It encodes messages field by field and doesn't
+// use the jelly package, so the file can be used to check the decoder independently of the encoder.
+//
+// The stream uses the same compression as reference encoders: lookup entries with zero ids,
+// IRIs with zero prefix and name ids, and terms repeated from the previous statement are omitted.
+// It contains the following quads:
+//
+//	<http://example.org/alice> <http://example.org/knows> <http://example.org/bob> .
+//	<http://example.org/alice> <http://xmlns.com/foaf/0.1/name> "Alice"@en .
+//	_:b1 <http://xmlns.com/foaf/0.1/age> "42"^^<http://www.w3.org/2001/XMLSchema#integer> <http://example.org/g> .
+//	_:b1 <http://example.org/knows> <http://example.org/alice> <http://example.org/g> .
+//
+// Run it with "go run gen.go" in this directory.
+package main
+
+import (
+	"log"
+	"os"
+
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// Field numbers from the rdf.proto of the specification.
+const (
+	// RdfStreamFrame
+	frameRows = 1
+
+	// RdfStreamRow
+	rowOptions  = 1
+	rowQuad     = 3
+	rowName     = 9
+	rowPrefix   = 10
+	rowDatatype = 11
+
+	// RdfStreamOptions
+	optPhysicalType     = 2
+	optMaxNameTable     = 9
+	optMaxPrefixTable   = 10
+	optMaxDatatypeTable = 11
+	optLogicalType      = 14
+	optVersion          = 15
+
+	// RdfQuad
+	quadSIri         = 1
+	quadSBnode       = 2
+	quadPIri         = 5
+	quadOIri         = 9
+	quadOLiteral     = 11
+	quadGIri         = 13
+	quadDefaultGraph = 15
+
+	// RdfIri
+	iriPrefix = 1
+	iriName   = 2
+
+	// RdfLiteral
+	litLex      = 1
+	litLang     = 2
+	litDatatype = 3
+
+	// RdfNameEntry, RdfPrefixEntry and RdfDatatypeEntry
+	entryValue = 2 // ids are omitted
+
+	physicalQuads   = 2
+	logicalFlatQuad = 2
+)
+
+func varint(b []byte, num protowire.Number, v uint64) []byte {
+	b = protowire.AppendTag(b, num, protowire.VarintType)
+	return protowire.AppendVarint(b, v)
+}
+
+func embed(b []byte, num protowire.Number, v []byte) []byte {
+	b = protowire.AppendTag(b, num, protowire.BytesType)
+	return protowire.AppendBytes(b, v)
+}
+
+func str(b []byte, num protowire.Number, v string) []byte {
+	return embed(b, num, []byte(v))
+}
+
+// iri encodes RdfIri. Zero ids are omitted, as proto3 does for default values.
+func iri(prefix, name uint64) []byte {
+	var b []byte
+	if prefix != 0 {
+		b = varint(b, iriPrefix, prefix)
+	}
+	if name != 0 {
+		b = varint(b, iriName, name)
+	}
+	return b
+}
+
+// entry encodes a lookup table entry with a zero id, which means the previous id plus one.
+func entry(value string) []byte {
+	return str(nil, entryValue, value)
+}
+
+func row(field protowire.Number, msg []byte) []byte {
+	return embed(nil, field, msg)
+}
+
+func frame(rows ...[]byte) []byte {
+	var b []byte
+	for _, r := range rows {
+		b = embed(b, frameRows, r)
+	}
+	return b
+}
+
+func main() {
+	var opts []byte
+	opts = varint(opts, optPhysicalType, physicalQuads)
+	opts = varint(opts, optMaxNameTable, 128)
+	opts = varint(opts, optMaxPrefixTable, 16)
+	opts = varint(opts, optMaxDatatypeTable, 16)
+	opts = varint(opts, optLogicalType, logicalFlatQuad)
+	opts = varint(opts, optVersion, 1)
+
+	// ex:alice ex:knows ex:bob, in the default graph
+	var q1 []byte
+	q1 = embed(q1, quadSIri, iri(1, 0)) // prefix 1, name 1
+	q1 = embed(q1, quadPIri, iri(0, 0)) // prefix 1, name 2
+	q1 = embed(q1, quadOIri, iri(0, 0)) // prefix 1, name 3
+	q1 = embed(q1, quadDefaultGraph, nil)
+
+	// the same subject and graph, foaf:name "Alice"@en
+	var q2 []byte
+	q2 = embed(q2, quadPIri, iri(2, 4))
+	q2 = embed(q2, quadOLiteral, str(str(nil, litLex, "Alice"), litLang, "en"))
+
+	// _:b1 foaf:age 42 ex:g
+	var q3 []byte
+	q3 = str(q3, quadSBnode, "b1")
+	q3 = embed(q3, quadPIri, iri(0, 0)) // prefix 2, name 5
+	q3 = embed(q3, quadOLiteral, varint(str(nil, litLex, "42"), litDatatype, 1))
+	q3 = embed(q3, quadGIri, iri(1, 0)) // prefix 1, name 6
+
+	// the same subject and graph, ex:knows ex:alice
+	var q4 []byte
+	q4 = embed(q4, quadPIri, iri(1, 2))
+	q4 = embed(q4, quadOIri, iri(0, 1))
+
+	frames := [][]byte{
+		frame(
+			row(rowOptions, opts),
+			row(rowPrefix, entry("http://example.org/")), // 1
+			row(rowName, entry("alice")),                 // 1
+			row(rowName, entry("knows")),                 // 2
+			row(rowName, entry("bob")),                   // 3
+			row(rowQuad, q1),
+		),
+		frame(
+			row(rowPrefix, entry("http://xmlns.com/foaf/0.1/")),                 // 2
+			row(rowName, entry("name")),                                         // 4
+			row(rowName, entry("age")),                                          // 5
+			row(rowName, entry("g")),                                            // 6
+			row(rowDatatype, entry("http://www.w3.org/2001/XMLSchema#integer")), // 1
+			row(rowQuad, q2),
+			row(rowQuad, q3),
+			row(rowQuad, q4),
+		),
+	}
+	var out []byte
+	for _, f := range frames {
+		out = protowire.AppendBytes(out, f)
+	}
+	if err := os.WriteFile("example.jelly", out, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
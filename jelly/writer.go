@@ -0,0 +1,309 @@
+package jelly
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/pquads/pio"
+	"github.com/cayleygraph/quad/voc/xsd"
+)
+
+var xsdString = quad.IRI(xsd.String).Full()
+
+// Options for the Jelly encoder.
+type Options struct {
+	// StreamName is an optional name of the stream.
+	StreamName string
+	// MaxNameTableSize, MaxPrefixTableSize and MaxDatatypeTableSize set the sizes of lookup tables.
+	// Default sizes are used if they are not set.
+	MaxNameTableSize     int
+	MaxPrefixTableSize   int
+	MaxDatatypeTableSize int
+	// FrameSize is a maximal number of rows in a single stream frame.
+	FrameSize int
+	// Generalized allows literals in the subject position and as a graph name,
+	// and any values in the predicate position.
+	Generalized bool
+	// RDFStar allows quoted triples in the subject and object positions.
+	RDFStar bool
+}
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// Writer implements Jelly stream generator. It always writes a stream of quads.
+//
+// Rows are buffered and written in frames of a fixed size.
+type Writer struct {
+	pw   pio.Writer
+	opts Options
+	err  error
+
+	names, prefixes, datatypes *lookup
+	lastPrefix, lastName       uint32
+
+	rows    []*RdfStreamRow
+	any     bool
+	s, p, o quad.Value
+	g       quad.Value
+}
+
+// NewWriter creates Jelly stream encoder. Options can be nil.
+func NewWriter(w io.Writer, opts *Options) *Writer {
+	if opts == nil {
+		opts = &Options{}
+	}
+	o := *opts
+	if o.MaxNameTableSize <= 0 {
+		o.MaxNameTableSize = DefaultMaxNameTableSize
+	} else if o.MaxNameTableSize < minNameTableSize {
+		o.MaxNameTableSize = minNameTableSize
+	}
+	if o.MaxPrefixTableSize <= 0 {
+		o.MaxPrefixTableSize = DefaultMaxPrefixTableSize
+	}
+	if o.MaxDatatypeTableSize <= 0 {
+		o.MaxDatatypeTableSize = DefaultMaxDatatypeTableSize
+	}
+	if o.FrameSize <= 0 {
+		o.FrameSize = DefaultFrameSize
+	}
+	jw := &Writer{
+		pw:        pio.NewWriter(w),
+		opts:      o,
+		names:     newLookup(o.MaxNameTableSize),
+		prefixes:  newLookup(o.MaxPrefixTableSize),
+		datatypes: newLookup(o.MaxDatatypeTableSize),
+	}
+	jw.rows = append(jw.rows, &RdfStreamRow{Row: &RdfStreamRow_Options{Options: &RdfStreamOptions{
+		StreamName:            o.StreamName,
+		PhysicalType:          PhysicalStreamType_PHYSICAL_STREAM_TYPE_QUADS,
+		GeneralizedStatements: o.Generalized,
+		RdfStar:               o.RDFStar,
+		MaxNameTableSize:      uint32(o.MaxNameTableSize),
+		MaxPrefixTableSize:    uint32(o.MaxPrefixTableSize),
+		MaxDatatypeTableSize:  uint32(o.MaxDatatypeTableSize),
+		LogicalType:           LogicalStreamType_LOGICAL_STREAM_TYPE_FLAT_QUADS,
+		Version:               currentVersion,
+	}}})
+	return jw
+}
+
+// iri encodes an IRI, adding lookup table entries if necessary.
+func (w *Writer) iri(s string) (*RdfIri, error) {
+	pref, name := splitIRI(s)
+	pid, isNew, err := w.prefixes.get(pref)
+	if err != nil {
+		return nil, err
+	} else if isNew {
+		w.rows = append(w.rows, &RdfStreamRow{Row: &RdfStreamRow_Prefix{Prefix: &RdfPrefixEntry{
+			Id: w.prefixes.entryID(pid), Value: pref,
+		}}})
+	}
+	nid, isNew, err := w.names.get(name)
+	if err != nil {
+		return nil, err
+	} else if isNew {
+		w.rows = append(w.rows, &RdfStreamRow{Row: &RdfStreamRow_Name{Name: &RdfNameEntry{
+			Id: w.names.entryID(nid), Value: name,
+		}}})
+	}
+	out := &RdfIri{PrefixId: pid, NameId: nid}
+	if pid == w.lastPrefix {
+		out.PrefixId = 0
+	}
+	if nid == w.lastName+1 {
+		out.NameId = 0
+	}
+	w.lastPrefix, w.lastName = pid, nid
+	return out, nil
+}
+
+// literal encodes a literal, adding lookup table entries if necessary.
+func (w *Writer) literal(v quad.Value) (*RdfLiteral, error) {
+	if ts, ok := v.(quad.TypedStringer); ok {
+		s := ts.TypedString()
+		s.Type = s.Type.Full()
+		v = s
+	}
+	switch v := v.(type) {
+	case quad.String:
+		return &RdfLiteral{Lex: string(v)}, nil
+	case quad.LangString:
+		return &RdfLiteral{Lex: string(v.Value), LiteralKind: &RdfLiteral_Langtag{Langtag: v.Lang}}, nil
+	case quad.TypedString:
+		dt := v.Type.Full()
+		if dt == xsdString {
+			return &RdfLiteral{Lex: string(v.Value)}, nil
+		}
+		id, isNew, err := w.datatypes.get(string(dt))
+		if err != nil {
+			return nil, err
+		} else if isNew {
+			w.rows = append(w.rows, &RdfStreamRow{Row: &RdfStreamRow_Datatype{Datatype: &RdfDatatypeEntry{
+				Id: w.datatypes.entryID(id), Value: string(dt),
+			}}})
+		}
+		return &RdfLiteral{Lex: string(v.Value), LiteralKind: &RdfLiteral_Datatype{Datatype: id}}, nil
+	}
+	return nil, fmt.Errorf("unsupported value: %v", v)
+}
+
+// term encodes a single value.
+func (w *Writer) term(v quad.Value) (t term, err error) {
+	switch v := v.(type) {
+	case quad.IRI:
+		t.iri, err = w.iri(string(v))
+	case quad.BNode:
+		t.bnode = string(v)
+	case quad.Triple:
+		t.triple, err = w.triple(v)
+	default:
+		t.lit, err = w.literal(v)
+	}
+	return t, err
+}
+
+// triple encodes a quoted triple. Terms of quoted triples are never omitted.
+func (w *Writer) triple(v quad.Triple) (*RdfTriple, error) {
+	if err := w.check(v.Subject, v.Predicate, v.Object, nil); err != nil {
+		return nil, err
+	}
+	s, err := w.term(v.Subject)
+	if err != nil {
+		return nil, err
+	}
+	p, err := w.term(v.Predicate)
+	if err != nil {
+		return nil, err
+	}
+	o, err := w.term(v.Object)
+	if err != nil {
+		return nil, err
+	}
+	return &RdfTriple{
+		Subject:   s.tripleSubject(),
+		Predicate: p.triplePredicate(),
+		Object:    o.tripleObject(),
+	}, nil
+}
+
+// check verifies that values are allowed by the stream options.
+func (w *Writer) check(s, p, o, g quad.Value) error {
+	switch s.(type) {
+	case quad.IRI, quad.BNode:
+	case quad.Triple:
+		if !w.opts.RDFStar {
+			return fmt.Errorf("unsupported subject value: %v", s)
+		}
+	default:
+		if !w.opts.Generalized {
+			return fmt.Errorf("unsupported subject value: %v", s)
+		}
+	}
+	switch p.(type) {
+	case quad.IRI:
+	default:
+		if !w.opts.Generalized {
+			return fmt.Errorf("unsupported predicate value: %v", p)
+		}
+	}
+	if _, ok := o.(quad.Triple); ok && !w.opts.RDFStar {
+		return fmt.Errorf("unsupported object value: %v", o)
+	}
+	switch g.(type) {
+	case nil, quad.IRI, quad.BNode:
+	case quad.Triple:
+		return fmt.Errorf("unsupported label value: %v", g)
+	default:
+		if !w.opts.Generalized {
+			return fmt.Errorf("unsupported label value: %v", g)
+		}
+	}
+	return nil
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	}
+	if err := w.check(q.Subject, q.Predicate, q.Object, q.Label); err != nil {
+		return err
+	}
+	w.names.gen++
+	w.prefixes.gen++
+	w.datatypes.gen++
+	// encoding errors are sticky, since lookup tables might be changed already
+	pq := &RdfQuad{}
+	if !w.any || q.Subject != w.s {
+		var t term
+		if t, w.err = w.term(q.Subject); w.err != nil {
+			return w.err
+		}
+		pq.Subject, w.s = t.quadSubject(), q.Subject
+	}
+	if !w.any || q.Predicate != w.p {
+		var t term
+		if t, w.err = w.term(q.Predicate); w.err != nil {
+			return w.err
+		}
+		pq.Predicate, w.p = t.quadPredicate(), q.Predicate
+	}
+	if !w.any || q.Object != w.o {
+		var t term
+		if t, w.err = w.term(q.Object); w.err != nil {
+			return w.err
+		}
+		pq.Object, w.o = t.quadObject(), q.Object
+	}
+	if !w.any || q.Label != w.g {
+		t := term{def: true}
+		if q.Label != nil {
+			if t, w.err = w.term(q.Label); w.err != nil {
+				return w.err
+			}
+		}
+		pq.Graph, w.g = t.quadGraph(), q.Label
+	}
+	w.any = true
+	w.rows = append(w.rows, &RdfStreamRow{Row: &RdfStreamRow_Quad{Quad: pq}})
+	if len(w.rows) >= w.opts.FrameSize {
+		w.flush()
+	}
+	return w.err
+}
+
+// WriteQuads implements quad.BatchWriter.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+// flush writes all buffered rows as a single frame.
+func (w *Writer) flush() {
+	if w.err != nil || len(w.rows) == 0 {
+		return
+	}
+	_, w.err = w.pw.WriteMsg(&RdfStreamFrame{Rows: w.rows})
+	w.rows = w.rows[:0]
+}
+
+// Close writes all buffered rows.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.flush()
+	if w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	return nil
+}
@@ -2,6 +2,7 @@
 package json
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -29,15 +30,44 @@ func init() {
 			}
 			return quad.StringToValue(*s), nil
 		},
+		Sniff: func(head []byte) bool {
+			return sniff(head, true)
+		},
 	})
 	quad.RegisterFormat(quad.Format{
 		Name:   "json-stream",
 		Mime:   []string{"application/x-json-stream"},
 		Writer: func(w io.Writer) quad.WriteCloser { return NewStreamWriter(w) },
 		Reader: func(r io.Reader) quad.ReadCloser { return NewStreamReader(r) },
+		Sniff: func(head []byte) bool {
+			return sniff(head, false)
+		},
 	})
 }
 
+// sniff checks that the stream starts with a quad object, optionally wrapped into an array.
+func sniff(head []byte, array bool) bool {
+	dec := json.NewDecoder(bytes.NewReader(head))
+	exp := []json.Delim{'{'}
+	if array {
+		exp = []json.Delim{'[', '{'}
+	}
+	for _, d := range exp {
+		if tok, err := dec.Token(); err != nil || tok != d {
+			return false
+		}
+	}
+	tok, err := dec.Token()
+	if err != nil {
+		return false
+	}
+	switch tok {
+	case "subject", "predicate", "object", "label":
+		return true
+	}
+	return false
+}
+
 func NewReader(r io.Reader) *Reader {
 	var quads []quad.Quad
 	err := json.NewDecoder(r).Decode(&quads)
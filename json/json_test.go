@@ -174,3 +174,91 @@ func TestValueEncoding(t *testing.T) {
 		require.Equal(t, v, v2)
 	}
 }
+
+func TestMappingReader(t *testing.T) {
+	const ex = "http://example.org/"
+	iri := func(s string) quad.IRI { return quad.IRI(ex + s) }
+	m := &Mapping{
+		Records: "$.data.items[*]",
+		Subject: ex + "person/{id}",
+		Fields: []FieldMapping{
+			{Path: "name", Predicate: iri("name"), Lang: "en"},
+			{Path: "age", Predicate: iri("age")},
+			{Path: "born", Predicate: iri("born"), Datatype: "http://www.w3.org/2001/XMLSchema#integer"},
+			{Path: "tags[*]", Predicate: iri("tag"), Object: ex + "tag/{@}"},
+			{Path: "address", Predicate: iri("address"), Fields: []FieldMapping{
+				{Path: "city", Predicate: iri("city")},
+			}},
+			{Path: "employer", Predicate: iri("worksFor"), Object: ex + "org/{id}", Fields: []FieldMapping{
+				{Path: "name", Predicate: iri("name")},
+			}},
+		},
+	}
+	const input = `{"meta": {"items": [1]}, "data": {"count": 2, "items": [
+		{"id": 1, "name": "Alice", "age": 30, "born": "1990", "tags": ["a b", "c"],
+		 "address": {"city": "Paris"}, "employer": {"id": "acme", "name": "ACME"}},
+		{"id": 2, "name": "Bob", "age": 1.5, "address": null, "extra": [{"x": {}}]}
+	]}}`
+	qr := NewMappingReader(strings.NewReader(input), m)
+	got, err := quad.ReadAll(qr)
+	require.NoError(t, err)
+	alice, bob := iri("person/1"), iri("person/2")
+	require.Len(t, got, 11)
+	addr := got[5].Object
+	require.IsType(t, quad.BNode(""), addr)
+	require.Equal(t, []quad.Quad{
+		{Subject: alice, Predicate: iri("name"), Object: quad.LangString{Value: "Alice", Lang: "en"}},
+		{Subject: alice, Predicate: iri("age"), Object: quad.Int(30)},
+		{Subject: alice, Predicate: iri("born"), Object: quad.Int(1990)},
+		{Subject: alice, Predicate: iri("tag"), Object: iri("tag/a%20b")},
+		{Subject: alice, Predicate: iri("tag"), Object: iri("tag/c")},
+		{Subject: alice, Predicate: iri("address"), Object: addr},
+		{Subject: addr, Predicate: iri("city"), Object: quad.String("Paris")},
+		{Subject: alice, Predicate: iri("worksFor"), Object: iri("org/acme")},
+		{Subject: iri("org/acme"), Predicate: iri("name"), Object: quad.String("ACME")},
+		{Subject: bob, Predicate: iri("name"), Object: quad.LangString{Value: "Bob", Lang: "en"}},
+		{Subject: bob, Predicate: iri("age"), Object: quad.Float(1.5)},
+	}, got)
+}
+
+func TestMappingReaderNDJSON(t *testing.T) {
+	m := &Mapping{
+		Graph:  "http://example.org/g",
+		Fields: []FieldMapping{{Path: "ok", Predicate: "http://example.org/ok"}},
+	}
+	const input = "{\"ok\": true}\n{\"ok\": false}\n"
+	got, err := quad.ReadAll(NewMappingReader(strings.NewReader(input), m))
+	require.NoError(t, err)
+	require.Len(t, got, 2)
+	r1, r2 := got[0].Subject, got[1].Subject
+	require.NotEqual(t, r1, r2)
+	require.Equal(t, []quad.Quad{
+		{Subject: r1, Predicate: quad.IRI("http://example.org/ok"), Object: quad.Bool(true), Label: quad.IRI("http://example.org/g")},
+		{Subject: r2, Predicate: quad.IRI("http://example.org/ok"), Object: quad.Bool(false), Label: quad.IRI("http://example.org/g")},
+	}, got)
+
+	// records of different documents are distinct
+	again, err := quad.ReadAll(NewMappingReader(strings.NewReader(input), m))
+	require.NoError(t, err)
+	require.NotEqual(t, r1, again[0].Subject)
+}
+
+func TestMappingReaderErrors(t *testing.T) {
+	for _, c := range []struct {
+		name  string
+		m     Mapping
+		input string
+	}{
+		{"no predicate", Mapping{Fields: []FieldMapping{{Path: "a"}}}, `{"a": 1}`},
+		{"bad template", Mapping{Subject: "{id"}, `{"id": 1}`},
+		{"missing subject", Mapping{Subject: "{id}"}, `{"a": 1}`},
+		{"object literal", Mapping{Fields: []FieldMapping{{Path: "a", Predicate: "p"}}}, `{"a": {"b": 1}}`},
+		{"bad datatype", Mapping{Fields: []FieldMapping{{Path: "a", Predicate: "p", Datatype: "http://www.w3.org/2001/XMLSchema#integer"}}}, `{"a": "x"}`},
+		{"bad json", Mapping{Records: "[*]"}, `[{}, {]`},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			_, err := quad.ReadAll(NewMappingReader(strings.NewReader(c.input), &c.m))
+			require.Error(t, err)
+		})
+	}
+}
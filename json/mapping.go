@@ -0,0 +1,445 @@
+package json
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/bnode"
+	"github.com/cayleygraph/quad/internal/iritemplate"
+)
+
+// Mapping describes how records of arbitrary JSON documents are converted to quads.
+//
+// Paths are simplified JSONPath expressions: dot-separated object keys, where "[*]" iterates
+// over array elements, for example "$.data.items[*]". The leading "$" is optional.
+//
+// Templates are IRI templates where paths in braces are replaced with percent-encoded values,
+// for example "http://example.org/person/{id}". Paths in templates are relative to the current
+// object, and "{@}" refers to the current value itself. Mapping can be decoded from JSON.
+type Mapping struct {
+	// Records is a path to records. Default is "$", which makes each top-level value a record,
+	// as in NDJSON documents.
+	Records string `json:"records,omitempty"`
+	// Subject is a template for subjects of records. If it's not set, records are generated blank nodes.
+	Subject string `json:"subject,omitempty"`
+	// Graph is a label for all quads. Optional.
+	Graph quad.IRI `json:"graph,omitempty"`
+	// Fields define properties of record subjects. Fields without a mapping are skipped.
+	Fields []FieldMapping `json:"fields"`
+}
+
+// FieldMapping describes how values of a field are converted to objects.
+type FieldMapping struct {
+	// Path to values, relative to the current object. Arrays are only iterated with "[*]".
+	Path string `json:"path"`
+	// Predicate for values of the field.
+	Predicate quad.IRI `json:"predicate"`
+	// Object is a template for IRI objects, relative to the value. If it's set, values are not used as literals.
+	Object string `json:"object,omitempty"`
+	// Datatype of literals. Values are converted to native types with registered string conversions.
+	Datatype quad.IRI `json:"datatype,omitempty"`
+	// Lang is a language tag of string literals.
+	Lang string `json:"lang,omitempty"`
+	// Fields define properties of nested objects. Objects are generated blank nodes, unless Object is set.
+	Fields []FieldMapping `json:"fields,omitempty"`
+}
+
+// step is a single path step: an object key or an array iteration.
+type step struct {
+	key   string
+	array bool
+}
+
+func parsePath(s string) ([]step, error) {
+	s = strings.TrimPrefix(s, "$")
+	var out []step
+	for s != "" {
+		switch {
+		case strings.HasPrefix(s, "[*]"):
+			out = append(out, step{array: true})
+			s = s[3:]
+		case strings.HasPrefix(s, "."):
+			s = s[1:]
+		default:
+			i := strings.IndexAny(s, ".[")
+			if i < 0 {
+				i = len(s)
+			} else if i == 0 {
+				return nil, fmt.Errorf("invalid path: %q", s)
+			}
+			out = append(out, step{key: s[:i]})
+			s = s[i:]
+		}
+	}
+	return out, nil
+}
+
+// eval returns values selected by the path. Missing keys and nulls are skipped.
+func eval(v interface{}, path []step) []interface{} {
+	if v == nil {
+		return nil
+	} else if len(path) == 0 {
+		return []interface{}{v}
+	}
+	st := path[0]
+	if st.array {
+		arr, _ := v.([]interface{})
+		var out []interface{}
+		for _, e := range arr {
+			out = append(out, eval(e, path[1:])...)
+		}
+		return out
+	}
+	obj, _ := v.(map[string]interface{})
+	return eval(obj[st.key], path[1:])
+}
+
+// template is a parsed IRI template with paths.
+type template struct {
+	*iritemplate.Template
+	paths [][]step // nil for the current value
+}
+
+func parseTemplate(s string) (*template, error) {
+	it, err := iritemplate.Parse(s)
+	if err != nil {
+		return nil, err
+	}
+	t := &template{Template: it}
+	for _, expr := range it.Exprs {
+		var path []step
+		if expr != "@" {
+			p, err := parsePath(expr)
+			if err != nil {
+				return nil, err
+			} else if len(p) == 0 {
+				return nil, fmt.Errorf("empty template expression")
+			}
+			path = p
+		}
+		t.paths = append(t.paths, path)
+	}
+	return t, nil
+}
+
+// scalar returns a string form of a scalar JSON value.
+func scalar(v interface{}) (string, bool) {
+	switch v := v.(type) {
+	case string:
+		return v, true
+	case json.Number:
+		return string(v), true
+	case bool:
+		return strconv.FormatBool(v), true
+	}
+	return "", false
+}
+
+// expand builds an IRI from a value.
+func (t *template) expand(v interface{}) (quad.IRI, error) {
+	return t.Expand(func(i int) (string, error) {
+		cur := v
+		if path := t.paths[i]; path != nil {
+			vals := eval(v, path)
+			if len(vals) != 1 {
+				return "", fmt.Errorf("template expression must select a single value, got %d", len(vals))
+			}
+			cur = vals[0]
+		}
+		s, ok := scalar(cur)
+		if !ok || s == "" {
+			return "", fmt.Errorf("template expression must select a non-empty scalar value")
+		}
+		return s, nil
+	})
+}
+
+// field is a compiled field mapping.
+type field struct {
+	FieldMapping
+	path   []step
+	object *template
+	fields []field
+}
+
+func compileFields(fields []FieldMapping) ([]field, error) {
+	out := make([]field, 0, len(fields))
+	for _, fm := range fields {
+		f := field{FieldMapping: fm}
+		var err error
+		if f.path, err = parsePath(fm.Path); err != nil {
+			return nil, fmt.Errorf("field %q: %w", fm.Path, err)
+		} else if fm.Predicate == "" {
+			return nil, fmt.Errorf("field %q: no predicate", fm.Path)
+		} else if fm.Datatype != "" && fm.Lang != "" {
+			return nil, fmt.Errorf("field %q: both datatype and language are set", fm.Path)
+		}
+		if fm.Object != "" {
+			if f.object, err = parseTemplate(fm.Object); err != nil {
+				return nil, fmt.Errorf("field %q: %w", fm.Path, err)
+			}
+		}
+		if f.fields, err = compileFields(fm.Fields); err != nil {
+			return nil, err
+		}
+		out = append(out, f)
+	}
+	return out, nil
+}
+
+// literal converts a scalar JSON value to a literal.
+func (f *field) literal(v interface{}) (quad.Value, error) {
+	s, ok := scalar(v)
+	if !ok {
+		return nil, fmt.Errorf("field %q: expected a scalar value, got %T", f.Path, v)
+	}
+	switch {
+	case f.Lang != "":
+		return quad.LangString{Value: quad.String(s), Lang: f.Lang}, nil
+	case f.Datatype != "":
+		return quad.TypedString{Value: quad.String(s), Type: f.Datatype}.ParseValue()
+	}
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return quad.Int(i), nil
+		}
+		fv, err := v.Float64()
+		return quad.Float(fv), err
+	case bool:
+		return quad.Bool(v), nil
+	}
+	return quad.String(s), nil
+}
+
+// frame is an open object or array on the path to records.
+type frame struct {
+	step  int // index of the path step that the container matches
+	array bool
+}
+
+var _ quad.ReadCloser = (*MappingReader)(nil)
+
+// MappingReader converts records of arbitrary JSON or NDJSON documents to quads according to a Mapping.
+//
+// Documents are streamed: only a single record is decoded at a time.
+type MappingReader struct {
+	dec *json.Decoder
+	m   Mapping
+	err error
+
+	records []step
+	subject *template
+	fields  []field
+
+	stack []frame
+	n     int // record number
+	seq   bnode.Generator
+	buf   []quad.Quad
+}
+
+// NewMappingReader returns a decoder that converts JSON records from the provided io.Reader to quads,
+// according to the mapping.
+func NewMappingReader(r io.Reader, m *Mapping) *MappingReader {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	mr := &MappingReader{dec: dec, m: *m}
+	mr.err = mr.compile()
+	return mr
+}
+
+func (r *MappingReader) compile() error {
+	var err error
+	if r.records, err = parsePath(r.m.Records); err != nil {
+		return fmt.Errorf("records: %w", err)
+	}
+	if r.m.Subject != "" {
+		if r.subject, err = parseTemplate(r.m.Subject); err != nil {
+			return fmt.Errorf("subject: %w", err)
+		}
+	}
+	r.fields, err = compileFields(r.m.Fields)
+	return err
+}
+
+func (r *MappingReader) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("record %d: "+format, append([]interface{}{r.n}, args...)...)
+}
+
+// skip skips the rest of a value, given its first token.
+func (r *MappingReader) skip(tok json.Token) error {
+	if d, ok := tok.(json.Delim); !ok || (d != '{' && d != '[') {
+		return nil
+	}
+	for depth := 1; depth > 0; {
+		tok, err := r.dec.Token()
+		if err != nil {
+			return err
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		}
+	}
+	return nil
+}
+
+// enter processes a value that matches the first i steps of the records path.
+// It returns a record if the whole path is matched.
+func (r *MappingReader) enter(i int) (interface{}, bool, error) {
+	if i == len(r.records) {
+		var v interface{}
+		if err := r.dec.Decode(&v); err != nil {
+			return nil, false, err
+		}
+		return v, true, nil
+	}
+	tok, err := r.dec.Token()
+	if err != nil {
+		return nil, false, err
+	}
+	want := json.Delim('{')
+	if r.records[i].array {
+		want = json.Delim('[')
+	}
+	if tok != want {
+		return nil, false, r.skip(tok)
+	}
+	r.stack = append(r.stack, frame{step: i, array: r.records[i].array})
+	return nil, false, nil
+}
+
+// record returns the next record from the stream.
+func (r *MappingReader) record() (interface{}, error) {
+	for {
+		if len(r.stack) == 0 {
+			if !r.dec.More() {
+				// check that there is no garbage at the end
+				if _, err := r.dec.Token(); err != io.EOF {
+					if err == nil {
+						err = fmt.Errorf("unexpected token")
+					}
+					return nil, err
+				}
+				return nil, io.EOF
+			}
+			if v, ok, err := r.enter(0); err != nil || ok {
+				return v, err
+			}
+			continue
+		}
+		f := r.stack[len(r.stack)-1]
+		if !r.dec.More() {
+			// closing delimiter
+			if _, err := r.dec.Token(); err != nil {
+				return nil, err
+			}
+			r.stack = r.stack[:len(r.stack)-1]
+			continue
+		}
+		if !f.array {
+			tok, err := r.dec.Token()
+			if err != nil {
+				return nil, err
+			} else if key, _ := tok.(string); key != r.records[f.step].key {
+				if tok, err = r.dec.Token(); err != nil {
+					return nil, err
+				} else if err = r.skip(tok); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+		if v, ok, err := r.enter(f.step + 1); err != nil || ok {
+			return v, err
+		}
+	}
+}
+
+// emit converts a value to quads, using mapped fields.
+func (r *MappingReader) emit(s quad.Value, v interface{}, fields []field) error {
+	for i := range fields {
+		f := &fields[i]
+		for _, fv := range eval(v, f.path) {
+			var o quad.Value
+			if f.object != nil {
+				iri, err := f.object.expand(fv)
+				if err != nil {
+					return r.errorf("field %q: %w", f.Path, err)
+				}
+				o = iri
+			} else if len(f.fields) != 0 {
+				o = r.seq.Next()
+			} else {
+				lit, err := f.literal(fv)
+				if err != nil {
+					return r.errorf("%w", err)
+				}
+				o = lit
+			}
+			q := quad.Quad{Subject: s, Predicate: f.Predicate, Object: o}
+			if r.m.Graph != "" {
+				q.Label = r.m.Graph
+			}
+			r.buf = append(r.buf, q)
+			if len(f.fields) != 0 {
+				if err := r.emit(o, fv, f.fields); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// next converts a single record to quads.
+func (r *MappingReader) next() error {
+	v, err := r.record()
+	if err != nil {
+		return err
+	}
+	r.n++
+	var s quad.Value = r.seq.Next()
+	if r.subject != nil {
+		iri, err := r.subject.expand(v)
+		if err != nil {
+			return r.errorf("subject: %w", err)
+		}
+		s = iri
+	}
+	return r.emit(s, v, r.fields)
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *MappingReader) ReadQuad() (quad.Quad, error) {
+	for len(r.buf) == 0 && r.err == nil {
+		r.err = r.next()
+	}
+	if len(r.buf) != 0 {
+		q := r.buf[0]
+		r.buf = r.buf[1:]
+		return q, nil
+	}
+	return quad.Quad{}, r.err
+}
+
+// ReadQuads implements quad.BatchReader.
+func (r *MappingReader) ReadQuads(buf []quad.Quad) (int, error) {
+	for i := range buf {
+		q, err := r.ReadQuad()
+		if err != nil {
+			return i, err
+		}
+		buf[i] = q
+	}
+	return len(buf), nil
+}
+
+// Close implements quad.ReadCloser.
+func (r *MappingReader) Close() error { return nil }
@@ -2,9 +2,11 @@
 package jsonld
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/cayleygraph/quad"
 	"github.com/cayleygraph/quad/voc"
@@ -25,9 +27,39 @@ func init() {
 		Mime:   []string{"application/ld+json"},
 		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w) },
 		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r) },
+		Sniff:  sniff,
 	})
 }
 
+// sniff checks that the stream is a JSON object, or an array of objects, that uses JSON-LD keywords.
+func sniff(head []byte) bool {
+	dec := json.NewDecoder(bytes.NewReader(head))
+	tok, err := dec.Token()
+	if err == nil && tok == json.Delim('[') {
+		tok, err = dec.Token()
+	}
+	if err != nil || tok != json.Delim('{') {
+		return false
+	}
+	for depth := 1; depth > 0; {
+		tok, err := dec.Token()
+		if err != nil {
+			return false
+		}
+		switch tok {
+		case json.Delim('{'), json.Delim('['):
+			depth++
+		case json.Delim('}'), json.Delim(']'):
+			depth--
+		default:
+			if s, ok := tok.(string); ok && depth == 1 && strings.HasPrefix(s, "@") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // NewReader returns quad reader for JSON-LD stream.
 func NewReader(r io.Reader) *Reader {
 	var o interface{}
@@ -0,0 +1,214 @@
+// Package mermaid provides an encoder for Mermaid flowchart diagrams.
+//
+// See https://mermaid.js.org/syntax/flowchart.html for the syntax definition.
+package mermaid
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/voc"
+)
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "mermaid",
+		Ext:    []string{".mmd"},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w, nil) },
+	})
+}
+
+// Options for the Mermaid encoder.
+type Options struct {
+	// MaxNodes limits the number of nodes in the diagram. Writer returns an error as soon
+	// as the limit is exceeded. Zero means no limit.
+	MaxNodes int
+}
+
+// edge is an edge between nodes with the given numbers.
+type edge struct {
+	s, o int
+	pred string
+}
+
+// group is a set of edges from the same graph.
+type group struct {
+	label quad.Value
+	edges []edge
+}
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns a Mermaid encoder that writes its output to the provided io.Writer.
+// Options can be nil.
+func NewWriter(w io.Writer, opts *Options) *Writer {
+	if opts == nil {
+		opts = &Options{}
+	}
+	return &Writer{
+		w: bufio.NewWriter(w), opts: *opts,
+		nodes:  make(map[string]int),
+		groups: make(map[string]*group),
+	}
+}
+
+// Writer implements Mermaid flowchart generator.
+//
+// Each quad is written as an edge labeled with its predicate. Nodes get generated IDs and are labeled
+// with their values, with IRIs shortened by registered namespaces. Named graphs are written as subgraphs,
+// after the edges of the default graph. Node labels are written on the first reference in the output.
+//
+// The whole diagram is kept in memory and is written on Close.
+type Writer struct {
+	w    *bufio.Writer
+	opts Options
+	err  error
+
+	nodes  map[string]int
+	labels []string // node labels by number
+	order  []*group // named graphs in order of appearance
+	def    group    // default graph
+	groups map[string]*group
+}
+
+var escaper = strings.NewReplacer(
+	`#`, `#35;`,
+	`"`, `#quot;`,
+	`&`, `#amp;`,
+	`<`, `#lt;`,
+	`>`, `#gt;`,
+	"\r\n", " ",
+	"\n", " ",
+	"\r", " ",
+)
+
+// escape quotes a text, replacing characters that cannot appear in it with entity codes.
+func escape(s string) string {
+	return `"` + escaper.Replace(s) + `"`
+}
+
+// label returns a human-readable label for a value.
+func label(v quad.Value) string {
+	switch v := v.(type) {
+	case quad.IRI:
+		return voc.ShortIRI(string(v))
+	case quad.String:
+		return string(v)
+	case quad.LangString:
+		return string(v.Value) + "@" + v.Lang
+	case quad.TypedString:
+		return string(v.Value) + "^^" + voc.ShortIRI(string(v.Type))
+	case quad.TypedStringer:
+		return string(v.TypedString().Value)
+	case quad.Triple:
+		return "<< " + label(v.Subject) + " " + label(v.Predicate) + " " + label(v.Object) + " >>"
+	}
+	return v.String()
+}
+
+// node returns a number of a node, adding it if necessary.
+func (w *Writer) node(v quad.Value) (int, error) {
+	key := v.String()
+	if i, ok := w.nodes[key]; ok {
+		return i, nil
+	}
+	if max := w.opts.MaxNodes; max > 0 && len(w.nodes) >= max {
+		return 0, fmt.Errorf("too many nodes: more than %d", max)
+	}
+	i := len(w.nodes)
+	w.nodes[key] = i
+	w.labels = append(w.labels, label(v))
+	return i, nil
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	}
+	s, err := w.node(q.Subject)
+	if err != nil {
+		w.err = err
+		return err
+	}
+	o, err := w.node(q.Object)
+	if err != nil {
+		w.err = err
+		return err
+	}
+	g := &w.def
+	if q.Label != nil {
+		key := q.Label.String()
+		if g = w.groups[key]; g == nil {
+			g = &group{label: q.Label}
+			w.groups[key] = g
+			w.order = append(w.order, g)
+		}
+	}
+	g.edges = append(g.edges, edge{s: s, o: o, pred: label(q.Predicate)})
+	return nil
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+func (w *Writer) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.w.WriteString(s)
+}
+
+// Close writes the diagram and flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	declared := make([]bool, len(w.labels))
+	// ref returns a reference to a node. The first reference includes the node label.
+	ref := func(i int) string {
+		id := "n" + strconv.Itoa(i)
+		if declared[i] {
+			return id
+		}
+		declared[i] = true
+		return id + "[" + escape(w.labels[i]) + "]"
+	}
+	writeEdge := func(indent string, e edge) {
+		w.writeString(indent + ref(e.s) + " -->|" + escape(e.pred) + "| " + ref(e.o) + "\n")
+	}
+	w.writeString(header)
+	for _, e := range w.def.edges {
+		writeEdge("\t", e)
+	}
+	for i, g := range w.order {
+		w.writeString("\tsubgraph g" + strconv.Itoa(i) + "[" + escape(label(g.label)) + "]\n")
+		for _, e := range g.edges {
+			writeEdge("\t\t", e)
+		}
+		w.writeString("\tend\n")
+	}
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	if w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	return nil
+}
+
+const header = "flowchart LR\n"
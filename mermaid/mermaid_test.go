@@ -0,0 +1,51 @@
+package mermaid_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/mermaid"
+	_ "github.com/cayleygraph/quad/voc/rdf"
+	_ "github.com/cayleygraph/quad/voc/schema"
+)
+
+func TestWriter(t *testing.T) {
+	quads := []quad.Quad{
+		{Subject: quad.IRI("http://schema.org/Person"), Predicate: quad.IRI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type"), Object: quad.IRI("http://schema.org/Class")},
+		{Subject: quad.BNode("alice"), Predicate: quad.IRI("http://schema.org/name"), Object: quad.String(`Alice "A" #1 <b>`), Label: quad.IRI("http://example.org/people")},
+		{Subject: quad.BNode("alice"), Predicate: quad.IRI("http://schema.org/knows"), Object: quad.BNode("bob"), Label: quad.IRI("http://example.org/people")},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("http://schema.org/age"), Object: quad.Int(42)},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("http://schema.org/name"), Object: quad.LangString{Value: "Bob\nSmith", Lang: "en"}, Label: quad.BNode("g")},
+	}
+	buf := bytes.NewBuffer(nil)
+	w := mermaid.NewWriter(buf, nil)
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, `flowchart LR
+	n0["schema:Person"] -->|"rdf:type"| n1["schema:Class"]
+	n4["_:bob"] -->|"schema:age"| n5["42"]
+	subgraph g0["http://example.org/people"]
+		n2["_:alice"] -->|"schema:name"| n3["Alice #quot;A#quot; #35;1 #lt;b#gt;"]
+		n2 -->|"schema:knows"| n4
+	end
+	subgraph g1["_:g"]
+		n4 -->|"schema:name"| n6["Bob Smith@en"]
+	end
+`, buf.String())
+}
+
+func TestWriterMaxNodes(t *testing.T) {
+	w := mermaid.NewWriter(bytes.NewBuffer(nil), &mermaid.Options{MaxNodes: 3})
+	q := func(s, o string) quad.Quad {
+		return quad.Quad{Subject: quad.BNode(s), Predicate: quad.IRI("p"), Object: quad.BNode(o)}
+	}
+	require.NoError(t, w.WriteQuad(q("a", "b")))
+	require.NoError(t, w.WriteQuad(q("b", "c")))
+	require.Error(t, w.WriteQuad(q("c", "d")))
+	require.Error(t, w.WriteQuad(q("a", "b")))
+	require.Error(t, w.Close())
+}
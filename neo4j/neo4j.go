@@ -0,0 +1,287 @@
+// Package neo4j provides an encoder for CSV files of the Neo4j bulk importer.
+//
+// See https://neo4j.com/docs/operations-manual/current/tools/neo4j-admin/neo4j-admin-import/ for the format definition.
+package neo4j
+
+import (
+	stdcsv "encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/pgraph"
+	"github.com/cayleygraph/quad/voc/rdf"
+)
+
+// File names used by Create.
+const (
+	NodesFile         = "nodes.csv"
+	RelationshipsFile = "relationships.csv"
+)
+
+// Property types, as written in header rows.
+const (
+	typeString   = "string"
+	typeLong     = "long"
+	typeDouble   = "double"
+	typeBoolean  = "boolean"
+	typeDateTime = "datetime"
+)
+
+// Options for the Neo4j encoder.
+type Options struct {
+	// Comma is a field delimiter. Default is ','.
+	Comma rune
+	// ArrayDelimiter separates values of array properties and labels. Default is ';'.
+	// It must match the --array-delimiter option of the importer.
+	ArrayDelimiter rune
+}
+
+// property is a node property column.
+type property struct {
+	name  string
+	typ   string
+	array bool
+}
+
+// node is a buffered node row.
+type node struct {
+	id     string
+	labels []string
+	props  map[int][]string // values by property column
+}
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns a Neo4j encoder that writes nodes and relationships to the provided io.Writers.
+// Options can be nil.
+func NewWriter(nodes, rels io.Writer, opts *Options) *Writer {
+	if opts == nil {
+		opts = &Options{}
+	}
+	o := *opts
+	if o.ArrayDelimiter == 0 {
+		o.ArrayDelimiter = ';'
+	}
+	nw, rw := stdcsv.NewWriter(nodes), stdcsv.NewWriter(rels)
+	if o.Comma != 0 {
+		nw.Comma, rw.Comma = o.Comma, o.Comma
+	}
+	return &Writer{
+		nw: nw, rw: rw, opts: o,
+		index: make(map[string]*node),
+		cols:  make(map[string]int),
+	}
+}
+
+// Create returns a Neo4j encoder that writes nodes and relationships to NodesFile and RelationshipsFile
+// in the directory, creating it if necessary. Options can be nil. Close closes the files.
+func Create(dir string, opts *Options) (*Writer, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	nodes, err := os.Create(filepath.Join(dir, NodesFile))
+	if err != nil {
+		return nil, err
+	}
+	rels, err := os.Create(filepath.Join(dir, RelationshipsFile))
+	if err != nil {
+		nodes.Close()
+		return nil, err
+	}
+	w := NewWriter(nodes, rels, opts)
+	w.files = []io.Closer{nodes, rels}
+	return w, nil
+}
+
+// Writer implements Neo4j bulk import CSV generator.
+//
+// Each IRI or blank node is a row of the nodes file, and its :ID value is referenced by the :START_ID
+// and :END_ID columns of the relationships file. Literal properties are written as typed columns named
+// by shortened predicate IRIs. A column with long and double values is declared as double, and a column
+// with other mixed types as string. A column becomes an array if any node has several values for it.
+// Values of rdf:type go to the :LABEL column. Quads with IRI or blank node objects are written as relationships,
+// typed by shortened predicate IRIs. The importer has no notion of named graphs, so quad labels are dropped.
+//
+// Relationship rows are written as quads arrive. The header of the nodes file depends on all properties,
+// so node rows are buffered until Close.
+type Writer struct {
+	nw, rw *stdcsv.Writer
+	opts   Options
+	files  []io.Closer
+	err    error
+
+	header bool // relationships header is written
+	order  []*node
+	index  map[string]*node
+	props  []property
+	cols   map[string]int
+}
+
+// name returns a property name, a label or a relationship type for a value.
+func name(v quad.Value) string {
+	if iri, ok := v.(quad.IRI); ok {
+		return string(iri.Short())
+	}
+	return quad.ToString(v)
+}
+
+// literal returns a property type and a string form of a literal.
+func literal(v quad.Value) (string, string) {
+	switch v := v.(type) {
+	case quad.Int:
+		return typeLong, strconv.FormatInt(int64(v), 10)
+	case quad.Float:
+		return typeDouble, strconv.FormatFloat(float64(v), 'g', -1, 64)
+	case quad.Bool:
+		return typeBoolean, strconv.FormatBool(bool(v))
+	case quad.Time:
+		return typeDateTime, time.Time(v).Format(time.RFC3339Nano)
+	case quad.LangString:
+		return typeString, string(v.Value)
+	case quad.TypedString:
+		return typeString, string(v.Value)
+	}
+	return typeString, quad.ToString(v)
+}
+
+// merge returns a type of a column that holds values of both types.
+func merge(a, b string) string {
+	switch {
+	case a == b:
+		return a
+	case (a == typeLong && b == typeDouble) || (a == typeDouble && b == typeLong):
+		return typeDouble
+	}
+	return typeString
+}
+
+// node returns a node for a value, adding it if necessary.
+func (w *Writer) node(v quad.Value) *node {
+	id := pgraph.NodeID(v)
+	n, ok := w.index[id]
+	if !ok {
+		n = &node{id: id}
+		w.index[id] = n
+		w.order = append(w.order, n)
+	}
+	return n
+}
+
+// writeHeader writes a header row of relationships, unless it's already written.
+func (w *Writer) writeHeader() {
+	if w.err != nil || w.header {
+		return
+	}
+	w.header = true
+	w.err = w.rw.Write([]string{":START_ID", ":END_ID", ":TYPE"})
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	} else if _, ok := q.Subject.(quad.Triple); ok {
+		return fmt.Errorf("quoted triples are not supported: %v", q.Subject)
+	}
+	n := w.node(q.Subject)
+	if p, ok := q.Predicate.(quad.IRI); ok && p.Full() == quad.IRI(rdf.Type).Full() {
+		n.labels = append(n.labels, name(q.Object))
+		return nil
+	} else if pgraph.IsNode(q.Object) {
+		dst := w.node(q.Object)
+		if w.writeHeader(); w.err != nil {
+			return w.err
+		}
+		w.err = w.rw.Write([]string{n.id, dst.id, name(q.Predicate)})
+		return w.err
+	}
+	pname := name(q.Predicate)
+	typ, s := literal(q.Object)
+	i, ok := w.cols[pname]
+	if !ok {
+		i = len(w.props)
+		w.cols[pname] = i
+		w.props = append(w.props, property{name: pname, typ: typ})
+	}
+	p := &w.props[i]
+	p.typ = merge(p.typ, typ)
+	if n.props == nil {
+		n.props = make(map[int][]string)
+	}
+	n.props[i] = append(n.props[i], s)
+	p.array = p.array || len(n.props[i]) > 1
+	return nil
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+// writeNodes writes a header row and all node rows.
+func (w *Writer) writeNodes() error {
+	row := make([]string, 0, len(w.props)+2)
+	row = append(row, ":ID")
+	for _, p := range w.props {
+		typ := p.typ
+		if p.array {
+			typ += "[]"
+		}
+		row = append(row, p.name+":"+typ)
+	}
+	row = append(row, ":LABEL")
+	if err := w.nw.Write(row); err != nil {
+		return err
+	}
+	sep := string(w.opts.ArrayDelimiter)
+	for _, n := range w.order {
+		row = append(row[:0], n.id)
+		for i := range w.props {
+			row = append(row, strings.Join(n.props[i], sep))
+		}
+		row = append(row, strings.Join(n.labels, sep))
+		if err := w.nw.Write(row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Close writes the nodes, flushes the output and closes files opened by Create.
+func (w *Writer) Close() error {
+	w.writeHeader()
+	if w.err == nil {
+		w.err = w.writeNodes()
+	}
+	if w.err == nil {
+		w.rw.Flush()
+		w.nw.Flush()
+		if w.err = w.rw.Error(); w.err == nil {
+			w.err = w.nw.Error()
+		}
+	}
+	for _, f := range w.files {
+		if err := f.Close(); err != nil && w.err == nil {
+			w.err = err
+		}
+	}
+	w.files = nil
+	if w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	return nil
+}
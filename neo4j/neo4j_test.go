@@ -0,0 +1,68 @@
+package neo4j_test
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/neo4j"
+	"github.com/cayleygraph/quad/voc/rdf"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+var testQuads = []quad.Quad{
+	{Subject: iri("alice"), Predicate: quad.IRI(rdf.Type), Object: iri("Person")},
+	{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String("Alice")},
+	{Subject: iri("alice"), Predicate: iri("age"), Object: quad.Int(30)},
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")},
+	{Subject: quad.BNode("bob"), Predicate: iri("age"), Object: quad.Float(2.5)},
+	{Subject: quad.BNode("bob"), Predicate: iri("nick"), Object: quad.LangString{Value: "Bobby", Lang: "en"}},
+	{Subject: quad.BNode("bob"), Predicate: iri("nick"), Object: quad.String("B, B")},
+	{Subject: quad.BNode("bob"), Predicate: iri("born"), Object: quad.Time(time.Date(2000, 1, 2, 3, 4, 5, 0, time.UTC))},
+	{Subject: iri("carol"), Predicate: quad.IRI(rdf.Type), Object: iri("Person")},
+	{Subject: iri("carol"), Predicate: quad.IRI(rdf.Type), Object: iri("Admin")},
+	{Subject: iri("carol"), Predicate: iri("active"), Object: quad.Bool(true)},
+}
+
+const (
+	testNodes = `:ID,http://example.org/name:string,http://example.org/age:double,http://example.org/nick:string[],http://example.org/born:datetime,http://example.org/active:boolean,:LABEL
+http://example.org/alice,Alice,30,,,,http://example.org/Person
+_:bob,,2.5,"Bobby;B, B",2000-01-02T03:04:05Z,,
+http://example.org/carol,,,,,true,http://example.org/Person;http://example.org/Admin
+`
+	testRels = `:START_ID,:END_ID,:TYPE
+http://example.org/alice,_:bob,http://example.org/knows
+`
+)
+
+func TestWriter(t *testing.T) {
+	var nodes, rels bytes.Buffer
+	w := neo4j.NewWriter(&nodes, &rels, nil)
+	n, err := w.WriteQuads(testQuads)
+	require.NoError(t, err)
+	require.Equal(t, len(testQuads), n)
+	require.NoError(t, w.Close())
+	require.Equal(t, testNodes, nodes.String())
+	require.Equal(t, testRels, rels.String())
+}
+
+func TestCreate(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "out")
+	w, err := neo4j.Create(dir, nil)
+	require.NoError(t, err)
+	_, err = w.WriteQuads(testQuads)
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	data, err := os.ReadFile(filepath.Join(dir, neo4j.NodesFile))
+	require.NoError(t, err)
+	require.Equal(t, testNodes, string(data))
+	data, err = os.ReadFile(filepath.Join(dir, neo4j.RelationshipsFile))
+	require.NoError(t, err)
+	require.Equal(t, testRels, string(data))
+}
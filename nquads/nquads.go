@@ -17,6 +17,8 @@
 //
 // Typed parsing is performed as based on a simplified grammar derived from
 // the N-Quads grammar defined by http://www.w3.org/TR/n-quads/.
+// It also accepts RDF-star quoted triples in the subject and object positions
+// as defined by https://www.w3.org/2021/12/rdf-star.html#n-triples-star.
 //
 // Raw parsing is performed as defined by http://www.w3.org/TR/n-quads/
 // with the exception that parser will allow relative IRI values,
@@ -76,9 +78,36 @@ func init() {
 			}
 			return q.Object, nil
 		},
+		Sniff: sniff,
 	})
 }
 
+// sniff checks that statements of the stream are valid N-Quads lines. The last line is ignored
+// if it may be truncated. Subjects must be IRIs or blank nodes, since the parser also accepts
+// unquoted strings, which are common in other formats.
+func sniff(head []byte) bool {
+	ok := false
+	for len(head) != 0 {
+		line := head
+		if i := bytes.IndexByte(head, '\n'); i >= 0 {
+			line, head = head[:i], head[i+1:]
+		} else if len(head) == quad.SniffLen && ok {
+			break
+		} else {
+			head = nil
+		}
+		if line = bytes.TrimSpace(line); len(line) == 0 || line[0] == '#' {
+			continue
+		} else if line[0] != '<' && !bytes.HasPrefix(line, []byte("_:")) {
+			return false
+		} else if _, err := Parse(string(line)); err != nil {
+			return false
+		}
+		ok = true
+	}
+	return ok
+}
+
 // Reader implements N-Quad document parsing according to the RDF
 // 1.1 N-Quads specification.
 type Reader struct {
@@ -0,0 +1,265 @@
+package nquads
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/cayleygraph/quad"
+)
+
+// Parse returns a valid quad.Quad or a non-nil error. Parse does
+// handle comments except where the comment placement does not prevent
+// a complete valid quad.Quad from being defined.
+//
+// Statements with RDF-star quoted triples (<< s p o >>) in the subject or
+// object position are supported as defined by N-Triples-star and N-Quads-star.
+func Parse(statement string) (quad.Quad, error) {
+	if !strings.Contains(statement, "<<") {
+		return parseTyped(statement)
+	}
+	return parseStar([]rune(statement))
+}
+
+// starParser is a hand-written parser for statements with quoted triples.
+//
+// It accepts the same terms as the typed parser, but allows quoted triples to be nested.
+type starParser struct {
+	data []rune
+	p    int
+}
+
+func parseStar(data []rune) (quad.Quad, error) {
+	s := &starParser{data: data}
+	var (
+		q   quad.Quad
+		err error
+	)
+	if q.Subject, err = s.term(false); err != nil {
+		return q, err
+	}
+	if q.Predicate, err = s.identifier(); err != nil {
+		return q, err
+	}
+	if q.Object, err = s.term(false); err != nil {
+		return q, err
+	}
+	s.skipSpace()
+	if s.p < len(s.data) && s.data[s.p] != '.' {
+		if q.Label, err = s.identifier(); err != nil {
+			return q, err
+		}
+		s.skipSpace()
+	}
+	if s.p >= len(s.data) {
+		return q, quad.ErrIncomplete
+	} else if s.data[s.p] != '.' {
+		return q, s.error()
+	}
+	s.p++
+	s.skipSpace()
+	if s.p < len(s.data) && s.data[s.p] != '#' {
+		return q, s.error()
+	}
+	return q, nil
+}
+
+func (s *starParser) error() error {
+	if s.p < len(s.data) {
+		r := s.data[s.p]
+		if r < unicode.MaxASCII {
+			return fmt.Errorf("%v: unexpected rune %q at %d", quad.ErrInvalid, r, s.p)
+		}
+		return fmt.Errorf("%v: unexpected rune %q (\\u%04x) at %d", quad.ErrInvalid, r, r, s.p)
+	}
+	return quad.ErrIncomplete
+}
+
+func (s *starParser) skipSpace() {
+	for s.p < len(s.data) && (s.data[s.p] == ' ' || s.data[s.p] == '\t') {
+		s.p++
+	}
+}
+
+func (s *starParser) hasPrefix(pref string) bool {
+	for i, r := range pref {
+		if s.p+i >= len(s.data) || s.data[s.p+i] != r {
+			return false
+		}
+	}
+	return true
+}
+
+// escape validates an escape sequence at the current position and skips it.
+func (s *starParser) escape(iri bool) error {
+	s.p++ // '\\'
+	if s.p >= len(s.data) {
+		return quad.ErrIncomplete
+	}
+	n := 0
+	switch s.data[s.p] {
+	case 'u':
+		n = 4
+	case 'U':
+		n = 8
+	case 't', 'b', 'n', 'r', 'f', '"', '\'', '\\':
+		if iri {
+			return s.error()
+		}
+	default:
+		return s.error()
+	}
+	s.p++
+	for ; n > 0; n-- {
+		if s.p >= len(s.data) {
+			return quad.ErrIncomplete
+		} else if !strings.ContainsRune("0123456789abcdefABCDEF", s.data[s.p]) {
+			return s.error()
+		}
+		s.p++
+	}
+	return nil
+}
+
+// identifier parses a term that cannot be a quoted triple.
+func (s *starParser) identifier() (quad.Value, error) {
+	s.skipSpace()
+	if s.hasPrefix("<<") {
+		return nil, s.error()
+	}
+	return s.term(false)
+}
+
+// term parses a single term. If inTriple is set, unquoted values are terminated by '>>'.
+func (s *starParser) term(inTriple bool) (quad.Value, error) {
+	s.skipSpace()
+	if s.p >= len(s.data) {
+		return nil, quad.ErrIncomplete
+	}
+	start := s.p
+	escaped := false
+	switch {
+	case s.hasPrefix("<<"):
+		s.p += 2
+		var (
+			t   quad.Triple
+			err error
+		)
+		if t.Subject, err = s.term(true); err != nil {
+			return nil, err
+		}
+		s.skipSpace()
+		if s.hasPrefix("<<") {
+			return nil, s.error()
+		}
+		if t.Predicate, err = s.term(true); err != nil {
+			return nil, err
+		}
+		if t.Object, err = s.term(true); err != nil {
+			return nil, err
+		}
+		s.skipSpace()
+		if s.p >= len(s.data) {
+			return nil, quad.ErrIncomplete
+		} else if !s.hasPrefix(">>") {
+			return nil, s.error()
+		}
+		s.p += 2
+		return t, nil
+	case s.data[s.p] == '"':
+		s.p++
+		for {
+			if s.p >= len(s.data) {
+				return nil, quad.ErrIncomplete
+			}
+			switch s.data[s.p] {
+			case '"':
+			case '\\':
+				escaped = true
+				if err := s.escape(false); err != nil {
+					return nil, err
+				}
+				continue
+			case '\n', '\r':
+				return nil, s.error()
+			default:
+				s.p++
+				continue
+			}
+			break
+		}
+		s.p++
+		spec := -1
+		if s.hasPrefix("@") {
+			spec = s.p
+			s.p++
+			for s.p < len(s.data) {
+				r := s.data[s.p]
+				if r != '-' && (r >= unicode.MaxASCII || !unicode.IsLetter(r) && !unicode.IsDigit(r)) {
+					break
+				}
+				s.p++
+			}
+			if s.p == spec+1 {
+				return nil, s.error()
+			}
+		} else if s.hasPrefix("^^<") {
+			spec = s.p
+			s.p += 2
+			if err := s.iri(); err != nil {
+				return nil, err
+			}
+		}
+		if spec < 0 {
+			return unEscape(s.data[start:s.p], -1, true, escaped), nil
+		}
+		return unEscape(s.data[start:s.p], spec-start, true, escaped), nil
+	case s.data[s.p] == '<':
+		if err := s.iri(); err != nil {
+			return nil, err
+		}
+	default:
+		for s.p < len(s.data) {
+			r := s.data[s.p]
+			if r == ' ' || r == '\t' || (inTriple && s.hasPrefix(">>")) {
+				break
+			} else if r == '.' && (s.p+1 == len(s.data) || strings.ContainsRune(" \t#", s.data[s.p+1])) {
+				break
+			} else if r == '\\' {
+				escaped = true
+				if err := s.escape(false); err != nil {
+					return nil, err
+				}
+				continue
+			}
+			s.p++
+		}
+		if s.p == start {
+			return nil, s.error()
+		}
+	}
+	return unEscape(s.data[start:s.p], -1, false, escaped), nil
+}
+
+// iri skips an IRI reference at the current position.
+func (s *starParser) iri() error {
+	s.p++ // '<'
+	for {
+		if s.p >= len(s.data) {
+			return quad.ErrIncomplete
+		}
+		switch s.data[s.p] {
+		case '>':
+			s.p++
+			return nil
+		case '\\':
+			if err := s.escape(true); err != nil {
+				return err
+			}
+			continue
+		case ' ', '\t', '<', '"', '{', '}', '|', '^', '`':
+			return s.error()
+		}
+		s.p++
+	}
+}
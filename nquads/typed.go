@@ -33,10 +33,10 @@ const typed_en_statement int = 1
 
 // line 129 "typed.rl"
 
-// ParseTyped returns a valid quad.Quad or a non-nil error. ParseTyped does
+// parseTyped returns a valid quad.Quad or a non-nil error. parseTyped does
 // handle comments except where the comment placement does not prevent
 // a complete valid quad.Quad from being defined.
-func Parse(statement string) (quad.Quad, error) {
+func parseTyped(statement string) (quad.Quad, error) {
 	data := []rune(statement)
 
 	var (
@@ -128,10 +128,10 @@ import (
 	write data;
 }%%
 
-// ParseTyped returns a valid quad.Quad or a non-nil error. ParseTyped does
+// parseTyped returns a valid quad.Quad or a non-nil error. parseTyped does
 // handle comments except where the comment placement does not prevent
 // a complete valid quad.Quad from being defined.
-func Parse(statement string) (quad.Quad, error) {
+func parseTyped(statement string) (quad.Quad, error) {
 	data := []rune(statement)
 
 	var (
@@ -16,6 +16,7 @@ package nquads
 
 import (
 	"archive/tar"
+	"bytes"
 	"compress/gzip"
 	"fmt"
 	"io"
@@ -850,6 +851,7 @@ func TestValueEncoding(t *testing.T) {
 		quad.BNode("bnode"),
 		quad.TypedString{Value: "10", Type: "int"},
 		quad.LangString{Value: "val", Lang: "en"},
+		quad.Triple{Subject: quad.IRI("s"), Predicate: quad.IRI("p"), Object: quad.String("o")},
 	}
 	enc := []string{
 		`"some val"`,
@@ -857,6 +859,7 @@ func TestValueEncoding(t *testing.T) {
 		`_:bnode`,
 		`"10"^^<int>`,
 		`"val"@en`,
+		`<< <s> <p> "o" >>`,
 	}
 	f := quad.FormatByName("nquads")
 	for i, v := range vals {
@@ -868,3 +871,97 @@ func TestValueEncoding(t *testing.T) {
 		require.Equal(t, v, v2)
 	}
 }
+
+var testStarQuads = []struct {
+	message string
+	input   string
+	expect  quad.Quad
+	err     error
+}{
+	{
+		message: "parse quoted triple subject",
+		input:   `<< <http://example/bob> <http://example/age> "42"^^<http://www.w3.org/2001/XMLSchema#integer> >> <http://example/certainty> "0.9"@en .`,
+		expect: quad.Quad{
+			Subject: quad.Triple{
+				Subject:   quad.IRI("http://example/bob"),
+				Predicate: quad.IRI("http://example/age"),
+				Object:    quad.Int(42),
+			},
+			Predicate: quad.IRI("http://example/certainty"),
+			Object:    quad.LangString{Value: "0.9", Lang: "en"},
+		},
+	},
+	{
+		message: "parse nested quoted triple object with label",
+		input:   `_:a <http://example/says> <<_:b <http://example/knows> <<<http://example/c> <http://example/name> "C \"<<\" >>">>>> <http://example/g> . # comment`,
+		expect: quad.Quad{
+			Subject:   quad.BNode("a"),
+			Predicate: quad.IRI("http://example/says"),
+			Object: quad.Triple{
+				Subject:   quad.BNode("b"),
+				Predicate: quad.IRI("http://example/knows"),
+				Object: quad.Triple{
+					Subject:   quad.IRI("http://example/c"),
+					Predicate: quad.IRI("http://example/name"),
+					Object:    quad.String(`C "<<" >>`),
+				},
+			},
+			Label: quad.IRI("http://example/g"),
+		},
+	},
+	{
+		message: "parse literal with quote marks",
+		input:   `<http://example/a> <http://example/p> "a << b" .`,
+		expect: quad.Quad{
+			Subject:   quad.IRI("http://example/a"),
+			Predicate: quad.IRI("http://example/p"),
+			Object:    quad.String("a << b"),
+		},
+	},
+	{
+		message: "reject quoted triple predicate",
+		input:   `<http://example/a> << <s> <p> <o> >> <http://example/b> .`,
+		err:     fmt.Errorf("%v: unexpected rune '<' at 19", quad.ErrInvalid),
+	},
+	{
+		message: "reject unterminated quoted triple",
+		input:   `<< <http://example/a> <http://example/p> <http://example/b> .`,
+		err:     fmt.Errorf("%v: unexpected rune '.' at 60", quad.ErrInvalid),
+	},
+	{
+		message: "reject incomplete quoted triple",
+		input:   `<< <http://example/a> <http://example/p>`,
+		err:     quad.ErrIncomplete,
+	},
+}
+
+func TestParseStar(t *testing.T) {
+	for _, test := range testStarQuads {
+		t.Run(test.message, func(t *testing.T) {
+			got, err := Parse(test.input)
+			if test.err != nil {
+				require.EqualError(t, err, test.err.Error())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, test.expect, got)
+		})
+	}
+}
+
+func TestWriterStar(t *testing.T) {
+	quads := []quad.Quad{
+		testStarQuads[0].expect,
+		testStarQuads[1].expect,
+	}
+	buf := bytes.NewBuffer(nil)
+	w := NewWriter(buf)
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.True(t, strings.HasPrefix(buf.String(), `<< <http://example/bob> <http://example/age> "42"^^`), buf.String())
+
+	got, err := quad.ReadAll(NewReader(buf, false))
+	require.NoError(t, err)
+	require.Equal(t, quads, got)
+}
@@ -30,6 +30,9 @@ func init() {
 		Reader:         func(r io.Reader) quad.ReadCloser { return NewReader(r, DefaultMaxSize) },
 		MarshalValue:   MarshalValue,
 		UnmarshalValue: UnmarshalValue,
+		Sniff: func(head []byte) bool {
+			return bytes.HasPrefix(head, magic[:])
+		},
 	})
 }
 
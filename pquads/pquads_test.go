@@ -52,6 +52,34 @@ var testData = []struct {
 			},
 		},
 	},
+	{
+		[]quad.Quad{
+			{
+				Subject: quad.Triple{
+					Subject:   quad.IRI("http://example.org/bob"),
+					Predicate: quad.IRI("http://example.org/age"),
+					Object:    quad.Int(42),
+				},
+				Predicate: quad.IRI("http://example.org/certainty"),
+				Object:    quad.Float(0.9),
+				Label:     nil,
+			},
+			{
+				Subject:   quad.IRI("http://example.org/alice"),
+				Predicate: quad.IRI("http://example.org/says"),
+				Object: quad.Triple{
+					Subject:   quad.BNode("b1"),
+					Predicate: quad.IRI("http://example.org/knows"),
+					Object: quad.Triple{
+						Subject:   quad.IRI("http://example.org/bob"),
+						Predicate: quad.IRI("http://example.org/name"),
+						Object:    quad.String("Bob"),
+					},
+				},
+				Label: quad.IRI("subgraph"),
+			},
+		},
+	},
 }
 
 func TestPQuads(t *testing.T) {
@@ -49,11 +49,21 @@ func MakeValue(qv quad.Value) *Value {
 			Seconds: seconds,
 			Nanos:   nanos,
 		}}}
+	case quad.Triple:
+		return &Value{Value: &Value_Triple_{makeTriple(v)}}
 	default:
 		panic(fmt.Errorf("unsupported type: %T", qv))
 	}
 }
 
+func makeTriple(t quad.Triple) *Value_Triple {
+	return &Value_Triple{
+		Subject:   MakeValue(t.Subject),
+		Predicate: MakeValue(t.Predicate),
+		Object:    MakeValue(t.Object),
+	}
+}
+
 // MarshalValue is a helper for serialization of quad.Value.
 func MarshalValue(v quad.Value) ([]byte, error) {
 	if v == nil {
@@ -112,11 +122,25 @@ func (m *Value) ToNative() (qv quad.Value) {
 			t = time.Unix(v.Time.Seconds, int64(v.Time.Nanos)).UTC()
 		}
 		return quad.Time(t)
+	case *Value_Triple_:
+		return v.Triple.ToNative()
 	default:
 		panic(fmt.Errorf("unsupported type: %T", m.Value))
 	}
 }
 
+// ToNative converts protobuf Triple to quad.Triple.
+func (m *Value_Triple) ToNative() quad.Value {
+	if m == nil {
+		return quad.Triple{}
+	}
+	return quad.Triple{
+		Subject:   m.Subject.ToNative(),
+		Predicate: m.Predicate.ToNative(),
+		Object:    m.Object.ToNative(),
+	}
+}
+
 // ToNative converts protobuf Value to quad.Value.
 func (m *StrictQuad_Ref) ToNative() (qv quad.Value) {
 	if m == nil {
@@ -127,6 +151,8 @@ func (m *StrictQuad_Ref) ToNative() (qv quad.Value) {
 		return quad.IRI(v.Iri)
 	case *StrictQuad_Ref_BnodeLabel:
 		return quad.BNode(v.BnodeLabel)
+	case *StrictQuad_Ref_Triple:
+		return v.Triple.ToNative()
 	default:
 		panic(fmt.Errorf("unsupported type: %T", m.Value))
 	}
@@ -152,6 +178,8 @@ func makeRef(v quad.Value) (*StrictQuad_Ref, error) {
 		sv = &StrictQuad_Ref_BnodeLabel{BnodeLabel: string(v)}
 	case quad.IRI:
 		sv = &StrictQuad_Ref_Iri{Iri: string(v)}
+	case quad.Triple:
+		sv = &StrictQuad_Ref_Triple{Triple: makeTriple(v)}
 	default:
 		return nil, fmt.Errorf("unexpected type for ref: %T", v)
 	}
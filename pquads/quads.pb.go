@@ -443,6 +443,7 @@ type Value struct {
 	//	*Value_Float
 	//	*Value_Boolean
 	//	*Value_Time
+	//	*Value_Triple_
 	Value isValue_Value `protobuf_oneof:"value"`
 }
 
@@ -555,6 +556,13 @@ func (x *Value) GetTime() *Value_Timestamp {
 	return nil
 }
 
+func (x *Value) GetTriple() *Value_Triple {
+	if x, ok := x.GetValue().(*Value_Triple_); ok {
+		return x.Triple
+	}
+	return nil
+}
+
 type isValue_Value interface {
 	isValue_Value()
 }
@@ -599,6 +607,10 @@ type Value_Time struct {
 	Time *Value_Timestamp `protobuf:"bytes,10,opt,name=time,proto3,oneof"`
 }
 
+type Value_Triple_ struct {
+	Triple *Value_Triple `protobuf:"bytes,11,opt,name=triple,proto3,oneof"`
+}
+
 func (*Value_Raw) isValue_Value() {}
 
 func (*Value_Str) isValue_Value() {}
@@ -619,6 +631,8 @@ func (*Value_Boolean) isValue_Value() {}
 
 func (*Value_Time) isValue_Value() {}
 
+func (*Value_Triple_) isValue_Value() {}
+
 type Header struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -686,6 +700,7 @@ type StrictQuad_Ref struct {
 	//
 	//	*StrictQuad_Ref_BnodeLabel
 	//	*StrictQuad_Ref_Iri
+	//	*StrictQuad_Ref_Triple
 	Value isStrictQuad_Ref_Value `protobuf_oneof:"value"`
 }
 
@@ -742,6 +757,13 @@ func (x *StrictQuad_Ref) GetIri() string {
 	return ""
 }
 
+func (x *StrictQuad_Ref) GetTriple() *Value_Triple {
+	if x, ok := x.GetValue().(*StrictQuad_Ref_Triple); ok {
+		return x.Triple
+	}
+	return nil
+}
+
 type isStrictQuad_Ref_Value interface {
 	isStrictQuad_Ref_Value()
 }
@@ -754,10 +776,16 @@ type StrictQuad_Ref_Iri struct {
 	Iri string `protobuf:"bytes,3,opt,name=iri,proto3,oneof"`
 }
 
+type StrictQuad_Ref_Triple struct {
+	Triple *Value_Triple `protobuf:"bytes,4,opt,name=triple,proto3,oneof"`
+}
+
 func (*StrictQuad_Ref_BnodeLabel) isStrictQuad_Ref_Value() {}
 
 func (*StrictQuad_Ref_Iri) isStrictQuad_Ref_Value() {}
 
+func (*StrictQuad_Ref_Triple) isStrictQuad_Ref_Value() {}
+
 type Value_TypedString struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -924,6 +952,70 @@ func (x *Value_Timestamp) GetNanos() int32 {
 	return 0
 }
 
+// Triple is an RDF-star quoted triple.
+type Value_Triple struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subject   *Value `protobuf:"bytes,1,opt,name=subject,proto3" json:"subject,omitempty"`
+	Predicate *Value `protobuf:"bytes,2,opt,name=predicate,proto3" json:"predicate,omitempty"`
+	Object    *Value `protobuf:"bytes,3,opt,name=object,proto3" json:"object,omitempty"`
+}
+
+func (x *Value_Triple) Reset() {
+	*x = Value_Triple{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_quads_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Value_Triple) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Value_Triple) ProtoMessage() {}
+
+func (x *Value_Triple) ProtoReflect() protoreflect.Message {
+	mi := &file_quads_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Value_Triple.ProtoReflect.Descriptor instead.
+func (*Value_Triple) Descriptor() ([]byte, []int) {
+	return file_quads_proto_rawDescGZIP(), []int{5, 3}
+}
+
+func (x *Value_Triple) GetSubject() *Value {
+	if x != nil {
+		return x.Subject
+	}
+	return nil
+}
+
+func (x *Value_Triple) GetPredicate() *Value {
+	if x != nil {
+		return x.Predicate
+	}
+	return nil
+}
+
+func (x *Value_Triple) GetObject() *Value {
+	if x != nil {
+		return x.Object
+	}
+	return nil
+}
+
 var File_quads_proto protoreflect.FileDescriptor
 
 var file_quads_proto_rawDesc = []byte{
@@ -966,7 +1058,7 @@ var file_quads_proto_rawDesc = []byte{
 	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65,
 	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
 	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0x96, 0x02, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x63,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xc6, 0x02, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x69, 0x63,
 	0x74, 0x51, 0x75, 0x61, 0x64, 0x12, 0x30, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x71, 0x75, 0x61, 0x64, 0x73, 0x2e,
 	0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x51, 0x75, 0x61, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x07,
@@ -979,10 +1071,13 @@ var file_quads_proto_rawDesc = []byte{
 	0x6a, 0x65, 0x63, 0x74, 0x12, 0x2c, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x71, 0x75, 0x61, 0x64, 0x73, 0x2e, 0x53, 0x74, 0x72,
 	0x69, 0x63, 0x74, 0x51, 0x75, 0x61, 0x64, 0x2e, 0x52, 0x65, 0x66, 0x52, 0x05, 0x6c, 0x61, 0x62,
-	0x65, 0x6c, 0x1a, 0x4b, 0x0a, 0x03, 0x52, 0x65, 0x66, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x6e, 0x6f,
+	0x65, 0x6c, 0x1a, 0x7b, 0x0a, 0x03, 0x52, 0x65, 0x66, 0x12, 0x21, 0x0a, 0x0b, 0x62, 0x6e, 0x6f,
 	0x64, 0x65, 0x5f, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
 	0x52, 0x0a, 0x62, 0x6e, 0x6f, 0x64, 0x65, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x03,
 	0x69, 0x72, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x69, 0x72, 0x69,
+	0x12, 0x2e, 0x0a, 0x06, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x71, 0x75, 0x61, 0x64, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e,
+	0x54, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x48, 0x00, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65,
 	0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4a, 0x04, 0x08, 0x01, 0x10, 0x02, 0x22,
 	0x75, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x51, 0x75, 0x61, 0x64, 0x52, 0x61, 0x77,
 	0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
@@ -991,7 +1086,7 @@ var file_quads_proto_rawDesc = []byte{
 	0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x62, 0x6a, 0x65,
 	0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65, 0x63, 0x74,
 	0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xfa, 0x03, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x22, 0xb2, 0x05, 0x0a, 0x05, 0x56, 0x61, 0x6c, 0x75, 0x65,
 	0x12, 0x12, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x48, 0x00, 0x52,
 	0x03, 0x72, 0x61, 0x77, 0x12, 0x12, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
 	0x09, 0x48, 0x00, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x12, 0x0a, 0x03, 0x69, 0x72, 0x69, 0x18,
@@ -1011,7 +1106,10 @@ var file_quads_proto_rawDesc = []byte{
 	0x28, 0x08, 0x48, 0x00, 0x52, 0x07, 0x62, 0x6f, 0x6f, 0x6c, 0x65, 0x61, 0x6e, 0x12, 0x2d, 0x0a,
 	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x71,
 	0x75, 0x61, 0x64, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x1a, 0x37, 0x0a, 0x0b,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x06,
+	0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70,
+	0x71, 0x75, 0x61, 0x64, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x2e, 0x54, 0x72, 0x69, 0x70,
+	0x6c, 0x65, 0x48, 0x00, 0x52, 0x06, 0x74, 0x72, 0x69, 0x70, 0x6c, 0x65, 0x1a, 0x37, 0x0a, 0x0b,
 	0x54, 0x79, 0x70, 0x65, 0x64, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x76,
 	0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
 	0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
@@ -1022,14 +1120,23 @@ var file_quads_proto_rawDesc = []byte{
 	0x09, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x65,
 	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x73, 0x65, 0x63,
 	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61,
-	0x6c, 0x75, 0x65, 0x22, 0x3b, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c,
-	0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e, 0x6f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74,
-	0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63,
-	0x61, 0x79, 0x6c, 0x65, 0x79, 0x67, 0x72, 0x61, 0x70, 0x68, 0x2f, 0x71, 0x75, 0x61, 0x64, 0x2f,
-	0x70, 0x71, 0x75, 0x61, 0x64, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x61, 0x6e, 0x6f, 0x73, 0x1a, 0x85, 0x01, 0x0a, 0x06, 0x54,
+	0x72, 0x69, 0x70, 0x6c, 0x65, 0x12, 0x27, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x71, 0x75, 0x61, 0x64, 0x73, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x2b,
+	0x0a, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x71, 0x75, 0x61, 0x64, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x52, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x06, 0x6f,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x70, 0x71,
+	0x75, 0x61, 0x64, 0x73, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x06, 0x6f, 0x62, 0x6a, 0x65,
+	0x63, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3b, 0x0a, 0x06, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x66, 0x75, 0x6c, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x6f, 0x74,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x6e,
+	0x6f, 0x74, 0x53, 0x74, 0x72, 0x69, 0x63, 0x74, 0x42, 0x24, 0x5a, 0x22, 0x67, 0x69, 0x74, 0x68,
+	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x61, 0x79, 0x6c, 0x65, 0x79, 0x67, 0x72, 0x61,
+	0x70, 0x68, 0x2f, 0x71, 0x75, 0x61, 0x64, 0x2f, 0x70, 0x71, 0x75, 0x61, 0x64, 0x73, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1044,7 +1151,7 @@ func file_quads_proto_rawDescGZIP() []byte {
 	return file_quads_proto_rawDescData
 }
 
-var file_quads_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_quads_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_quads_proto_goTypes = []any{
 	(*Quad)(nil),              // 0: pquads.Quad
 	(*WireQuad)(nil),          // 1: pquads.WireQuad
@@ -1057,6 +1164,7 @@ var file_quads_proto_goTypes = []any{
 	(*Value_TypedString)(nil), // 8: pquads.Value.TypedString
 	(*Value_LangString)(nil),  // 9: pquads.Value.LangString
 	(*Value_Timestamp)(nil),   // 10: pquads.Value.Timestamp
+	(*Value_Triple)(nil),      // 11: pquads.Value.Triple
 }
 var file_quads_proto_depIdxs = []int32{
 	5,  // 0: pquads.Quad.subject_value:type_name -> pquads.Value
@@ -1074,11 +1182,16 @@ var file_quads_proto_depIdxs = []int32{
 	8,  // 12: pquads.Value.typed_str:type_name -> pquads.Value.TypedString
 	9,  // 13: pquads.Value.lang_str:type_name -> pquads.Value.LangString
 	10, // 14: pquads.Value.time:type_name -> pquads.Value.Timestamp
-	15, // [15:15] is the sub-list for method output_type
-	15, // [15:15] is the sub-list for method input_type
-	15, // [15:15] is the sub-list for extension type_name
-	15, // [15:15] is the sub-list for extension extendee
-	0,  // [0:15] is the sub-list for field type_name
+	11, // 15: pquads.Value.triple:type_name -> pquads.Value.Triple
+	11, // 16: pquads.StrictQuad.Ref.triple:type_name -> pquads.Value.Triple
+	5,  // 17: pquads.Value.Triple.subject:type_name -> pquads.Value
+	5,  // 18: pquads.Value.Triple.predicate:type_name -> pquads.Value
+	5,  // 19: pquads.Value.Triple.object:type_name -> pquads.Value
+	20, // [20:20] is the sub-list for method output_type
+	20, // [20:20] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_quads_proto_init() }
@@ -1219,6 +1332,18 @@ func file_quads_proto_init() {
 				return nil
 			}
 		}
+		file_quads_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*Value_Triple); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	file_quads_proto_msgTypes[5].OneofWrappers = []any{
 		(*Value_Raw)(nil),
@@ -1231,10 +1356,12 @@ func file_quads_proto_init() {
 		(*Value_Float)(nil),
 		(*Value_Boolean)(nil),
 		(*Value_Time)(nil),
+		(*Value_Triple_)(nil),
 	}
 	file_quads_proto_msgTypes[7].OneofWrappers = []any{
 		(*StrictQuad_Ref_BnodeLabel)(nil),
 		(*StrictQuad_Ref_Iri)(nil),
+		(*StrictQuad_Ref_Triple)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1242,7 +1369,7 @@ func file_quads_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_quads_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
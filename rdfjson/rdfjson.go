@@ -0,0 +1,150 @@
+// Package rdfjson provides an encoder/decoder for RDF/JSON (Talis) format.
+//
+// See https://www.w3.org/TR/rdf-json/ for the format definition.
+package rdfjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+)
+
+// AutoConvertTypedString allows to convert TypedString values to native
+// equivalents directly while parsing. It will call ToNative on all TypedString values.
+//
+// If conversion error occurs, it will preserve original TypedString value.
+var AutoConvertTypedString = true
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "rdfjson",
+		Ext:    []string{".rj"},
+		Mime:   []string{"application/rdf+json"},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r) },
+		MarshalValue: func(v quad.Value) ([]byte, error) {
+			if v == nil {
+				return []byte("null"), nil
+			}
+			return json.Marshal(newObject(v))
+		},
+		UnmarshalValue: func(b []byte) (quad.Value, error) {
+			var o *object
+			if err := json.Unmarshal(b, &o); err != nil {
+				return nil, err
+			} else if o == nil {
+				return nil, nil
+			}
+			return o.toValue()
+		},
+		Sniff: sniff,
+	})
+}
+
+// sniff checks that the stream starts with a subject object that contains an array of value objects.
+func sniff(head []byte) bool {
+	dec := json.NewDecoder(bytes.NewReader(head))
+	for _, exp := range []interface{}{json.Delim('{'), nil, json.Delim('{'), nil, json.Delim('['), json.Delim('{')} {
+		tok, err := dec.Token()
+		if err != nil {
+			return false
+		} else if exp == nil {
+			// subject or predicate key
+			if s, ok := tok.(string); !ok || strings.HasPrefix(s, "@") {
+				return false
+			}
+		} else if tok != exp {
+			return false
+		}
+	}
+	tok, err := dec.Token()
+	if err != nil {
+		return false
+	}
+	switch tok {
+	case "type", "value", "lang", "datatype":
+		return true
+	}
+	return false
+}
+
+const (
+	typeURI     = "uri"
+	typeBNode   = "bnode"
+	typeLiteral = "literal"
+)
+
+// object is a descriptor of a single object value.
+type object struct {
+	Type     string `json:"type"`
+	Value    string `json:"value"`
+	Lang     string `json:"lang,omitempty"`
+	Datatype string `json:"datatype,omitempty"`
+}
+
+func newObject(v quad.Value) object {
+	if ts, ok := v.(quad.TypedStringer); ok {
+		s := ts.TypedString()
+		s.Type = s.Type.Full()
+		v = s
+	}
+	switch v := v.(type) {
+	case quad.IRI:
+		return object{Type: typeURI, Value: string(v)}
+	case quad.BNode:
+		return object{Type: typeBNode, Value: v.String()}
+	case quad.String:
+		return object{Type: typeLiteral, Value: string(v)}
+	case quad.LangString:
+		return object{Type: typeLiteral, Value: string(v.Value), Lang: v.Lang}
+	case quad.TypedString:
+		return object{Type: typeLiteral, Value: string(v.Value), Datatype: string(v.Type)}
+	}
+	return object{Type: typeLiteral, Value: v.String()}
+}
+
+func (o object) toValue() (quad.Value, error) {
+	switch o.Type {
+	case typeURI:
+		return quad.IRI(o.Value), nil
+	case typeBNode:
+		return quad.BNode(strings.TrimPrefix(o.Value, "_:")), nil
+	case typeLiteral:
+		if o.Lang != "" {
+			return quad.LangString{Value: quad.String(o.Value), Lang: o.Lang}, nil
+		} else if o.Datatype == "" {
+			return quad.String(o.Value), nil
+		}
+		v := quad.TypedString{Value: quad.String(o.Value), Type: quad.IRI(o.Datatype)}
+		if AutoConvertTypedString {
+			if nv, err := v.ParseValue(); err == nil {
+				return nv, nil
+			}
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("unsupported object type: %q", o.Type)
+}
+
+// subject converts a subject key to a value.
+func subject(s string) quad.Value {
+	if strings.HasPrefix(s, "_:") {
+		return quad.BNode(s[2:])
+	}
+	return quad.IRI(s)
+}
+
+// subjectKey converts a subject value to a key.
+func subjectKey(v quad.Value) (string, bool) {
+	switch v := v.(type) {
+	case quad.IRI:
+		return string(v), true
+	case quad.BNode:
+		return v.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,98 @@
+package rdfjson_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/rdfjson"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+var testQuads = []quad.Quad{
+	{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String(`Alice "A"`)},
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")},
+	{Subject: quad.BNode("bob"), Predicate: iri("title"), Object: quad.LangString{Value: "Dr", Lang: "en"}},
+	{Subject: quad.BNode("bob"), Predicate: iri("title"), Object: quad.String("Doctor")},
+	{Subject: quad.BNode("bob"), Predicate: iri("age"), Object: quad.Int(42)},
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("carol")},
+	{Subject: iri("alice"), Predicate: iri("born"), Object: quad.TypedString{Value: "1990-07", Type: "http://www.w3.org/2001/XMLSchema#gYearMonth"}},
+}
+
+const testData = `{
+	"http://example.org/alice": {
+		"http://example.org/name": [{"type":"literal","value":"Alice \"A\""}],
+		"http://example.org/knows": [{"type":"bnode","value":"_:bob"},{"type":"uri","value":"http://example.org/carol"}],
+		"http://example.org/born": [{"type":"literal","value":"1990-07","datatype":"http://www.w3.org/2001/XMLSchema#gYearMonth"}]
+	},
+	"_:bob": {
+		"http://example.org/title": [{"type":"literal","value":"Dr","lang":"en"},{"type":"literal","value":"Doctor"}],
+		"http://example.org/age": [{"type":"literal","value":"42","datatype":"http://www.w3.org/2001/XMLSchema#integer"}]
+	}
+}
+`
+
+func TestWriter(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	w := rdfjson.NewWriter(buf)
+	_, err := quad.Copy(w, quad.NewReader(testQuads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, testData, buf.String())
+
+	got, err := quad.ReadAll(rdfjson.NewReader(buf))
+	require.NoError(t, err)
+	require.ElementsMatch(t, testQuads, got)
+}
+
+func TestWriterEmpty(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	w := rdfjson.NewWriter(buf)
+	require.NoError(t, w.Close())
+	require.Equal(t, "{}\n", buf.String())
+
+	got, err := quad.ReadAll(rdfjson.NewReader(buf))
+	require.NoError(t, err)
+	require.Empty(t, got)
+}
+
+func TestReader(t *testing.T) {
+	r := rdfjson.NewReader(strings.NewReader(testData))
+	defer r.Close()
+	quads, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, []quad.Quad{
+		testQuads[0], testQuads[1], testQuads[5], testQuads[6],
+		testQuads[2], testQuads[3], testQuads[4],
+	}, quads)
+}
+
+func TestReaderErrors(t *testing.T) {
+	_, err := quad.ReadAll(rdfjson.NewReader(strings.NewReader(`[]`)))
+	require.EqualError(t, err, `expected {, got [`)
+
+	_, err = quad.ReadAll(rdfjson.NewReader(strings.NewReader(`{"http://example.org/a": {"http://example.org/p": [{"type":"triple","value":"x"}]}}`)))
+	require.EqualError(t, err, `unsupported object type: "triple"`)
+
+	_, err = quad.ReadAll(rdfjson.NewReader(strings.NewReader(`{"http://example.org/a": {`)))
+	require.EqualError(t, err, `expected key: unexpected EOF`)
+}
+
+func TestValue(t *testing.T) {
+	f := quad.FormatByMime("application/rdf+json")
+	require.NotNil(t, f)
+	for _, v := range []quad.Value{
+		iri("a"), quad.BNode("b"), quad.String("c"),
+		quad.LangString{Value: "d", Lang: "en"}, quad.Int(1),
+	} {
+		b, err := f.MarshalValue(v)
+		require.NoError(t, err)
+		got, err := f.UnmarshalValue(b)
+		require.NoError(t, err)
+		require.Equal(t, v, got)
+	}
+}
@@ -0,0 +1,130 @@
+package rdfjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cayleygraph/quad"
+)
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+// Reader implements RDF/JSON document parsing.
+//
+// The document is decoded incrementally, one predicate at a time.
+type Reader struct {
+	dec *json.Decoder
+
+	started bool
+	s, p    quad.Value
+	objs    []object
+	cur     int
+	err     error
+}
+
+// NewReader returns an RDF/JSON decoder that takes its input from the provided io.Reader.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{dec: json.NewDecoder(r)}
+}
+
+// expect reads a delimiter token from the input.
+func (r *Reader) expect(d json.Delim) error {
+	tok, err := r.dec.Token()
+	if err == io.EOF {
+		return fmt.Errorf("expected %v: %w", d, io.ErrUnexpectedEOF)
+	} else if err != nil {
+		return err
+	} else if tok != d {
+		return fmt.Errorf("expected %v, got %v", d, tok)
+	}
+	return nil
+}
+
+// key reads an object key or reports the end of an object.
+func (r *Reader) key() (string, bool, error) {
+	tok, err := r.dec.Token()
+	if err == io.EOF {
+		return "", false, fmt.Errorf("expected key: %w", io.ErrUnexpectedEOF)
+	} else if err != nil {
+		return "", false, err
+	}
+	switch tok := tok.(type) {
+	case string:
+		return tok, true, nil
+	case json.Delim:
+		if tok == '}' {
+			return "", false, nil
+		}
+	}
+	return "", false, fmt.Errorf("expected key, got %v", tok)
+}
+
+// next reads the next predicate with its objects.
+func (r *Reader) next() error {
+	if !r.started {
+		r.started = true
+		tok, err := r.dec.Token()
+		if err != nil {
+			return err
+		} else if tok != json.Delim('{') {
+			return fmt.Errorf("expected {, got %v", tok)
+		}
+	}
+	for {
+		if r.s == nil {
+			s, ok, err := r.key()
+			if err != nil {
+				return err
+			} else if !ok {
+				if _, err := r.dec.Token(); err != io.EOF {
+					if err == nil {
+						err = fmt.Errorf("unexpected data after the document")
+					}
+					return err
+				}
+				return io.EOF
+			}
+			if err := r.expect('{'); err != nil {
+				return err
+			}
+			r.s = subject(s)
+		}
+		p, ok, err := r.key()
+		if err != nil {
+			return err
+		} else if !ok {
+			r.s = nil
+			continue
+		}
+		r.p = quad.IRI(p)
+		r.objs, r.cur = nil, 0
+		if err := r.dec.Decode(&r.objs); err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	if r.err != nil {
+		return quad.Quad{}, r.err
+	}
+	for r.cur >= len(r.objs) {
+		if err := r.next(); err != nil {
+			r.err = err
+			return quad.Quad{}, err
+		}
+	}
+	o, err := r.objs[r.cur].toValue()
+	r.cur++
+	if err != nil {
+		r.err = err
+		return quad.Quad{}, err
+	}
+	return quad.Quad{Subject: r.s, Predicate: r.p, Object: o}, nil
+}
+
+// Close implements quad.ReadCloser.
+func (r *Reader) Close() error { return nil }
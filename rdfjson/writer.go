@@ -0,0 +1,131 @@
+package rdfjson
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/cayleygraph/quad"
+)
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns an RDF/JSON encoder that writes its output to the provided io.Writer.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: bufio.NewWriter(w), subj: make(map[string]*subjectEntry)}
+}
+
+// Writer implements RDF/JSON document generator.
+//
+// Since all predicates of a subject must be written in a single JSON object, quads are
+// buffered in memory and written on Close. Subjects and predicates preserve the order of
+// their first appearance. Quad labels are ignored, since RDF/JSON has no support for named graphs.
+type Writer struct {
+	w     *bufio.Writer
+	order []string
+	subj  map[string]*subjectEntry
+	err   error
+}
+
+type subjectEntry struct {
+	order []string
+	preds map[string][]object
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	}
+	s, ok := subjectKey(q.Subject)
+	if !ok {
+		return fmt.Errorf("unsupported subject value: %v", q.Subject)
+	}
+	p, ok := q.Predicate.(quad.IRI)
+	if !ok {
+		return fmt.Errorf("unsupported predicate value: %v", q.Predicate)
+	}
+	e := w.subj[s]
+	if e == nil {
+		e = &subjectEntry{preds: make(map[string][]object)}
+		w.subj[s] = e
+		w.order = append(w.order, s)
+	}
+	objs, ok := e.preds[string(p)]
+	if !ok {
+		e.order = append(e.order, string(p))
+	}
+	e.preds[string(p)] = append(objs, newObject(q.Object))
+	return nil
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+func (w *Writer) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.w.WriteString(s)
+}
+
+func (w *Writer) writeJSON(v interface{}) {
+	if w.err != nil {
+		return
+	}
+	var b []byte
+	b, w.err = json.Marshal(v)
+	if w.err == nil {
+		_, w.err = w.w.Write(b)
+	}
+}
+
+// Close writes the document and flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	w.writeString("{")
+	for i, s := range w.order {
+		if i != 0 {
+			w.writeString(",")
+		}
+		w.writeString("\n\t")
+		w.writeJSON(s)
+		w.writeString(": {")
+		e := w.subj[s]
+		for j, p := range e.order {
+			if j != 0 {
+				w.writeString(",")
+			}
+			w.writeString("\n\t\t")
+			w.writeJSON(p)
+			w.writeString(": ")
+			w.writeJSON(e.preds[p])
+		}
+		w.writeString("\n\t}")
+	}
+	if len(w.order) != 0 {
+		w.writeString("\n")
+	}
+	w.writeString("}\n")
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	if w.err != nil {
+		return w.err
+	}
+	w.order, w.subj = nil, nil
+	w.err = fmt.Errorf("closed")
+	return nil
+}
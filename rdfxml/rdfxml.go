@@ -0,0 +1,73 @@
+// Package rdfxml implements parsing and serialization of the RDF 1.1 XML syntax.
+//
+// See https://www.w3.org/TR/rdf-syntax-grammar/ for the grammar definition.
+package rdfxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"io"
+	"net/url"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/voc/rdf"
+)
+
+// AutoConvertTypedString allows to convert TypedString values to native
+// equivalents directly while parsing. It will call ToNative on all TypedString values.
+//
+// If conversion error occurs, it will preserve original TypedString value.
+var AutoConvertTypedString = true
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "rdfxml",
+		Ext:    []string{".rdf", ".owl"},
+		Mime:   []string{"application/rdf+xml"},
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r) },
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w, nil) },
+		Sniff:  sniff,
+	})
+}
+
+// sniff checks the root element of an XML document. It must be either rdf:RDF or a node element
+// from the RDF namespace.
+func sniff(head []byte) bool {
+	dec := xml.NewDecoder(bytes.NewReader(head))
+	// entities declared in DOCTYPE are not expanded
+	dec.Strict = false
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return false
+		} else if el, ok := tok.(xml.StartElement); ok {
+			return el.Name.Space == rdf.NS
+		}
+	}
+}
+
+const (
+	xmlNS = "http://www.w3.org/XML/1998/namespace"
+
+	rdfType       = quad.IRI(rdf.NS + "type")
+	rdfFirst      = quad.IRI(rdf.NS + "first")
+	rdfRest       = quad.IRI(rdf.NS + "rest")
+	rdfNil        = quad.IRI(rdf.NS + "nil")
+	rdfStatement  = quad.IRI(rdf.NS + "Statement")
+	rdfSubject    = quad.IRI(rdf.NS + "subject")
+	rdfPredicate  = quad.IRI(rdf.NS + "predicate")
+	rdfObject     = quad.IRI(rdf.NS + "object")
+	rdfXMLLiteral = quad.IRI(rdf.NS + "XMLLiteral")
+)
+
+// resolve resolves IRI relative to a given base.
+func resolve(base *url.URL, iri string) quad.IRI {
+	if base == nil {
+		return quad.IRI(iri)
+	}
+	u, err := url.Parse(iri)
+	if err != nil || u.IsAbs() {
+		return quad.IRI(iri)
+	}
+	return quad.IRI(base.ResolveReference(u).String())
+}
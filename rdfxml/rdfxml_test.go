@@ -0,0 +1,262 @@
+package rdfxml_test
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/rdfxml"
+	"github.com/cayleygraph/quad/voc"
+	"github.com/cayleygraph/quad/voc/rdf"
+	"github.com/cayleygraph/quad/voc/rdfs"
+)
+
+const (
+	rdfNS   = "http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	rdfType = quad.IRI(rdfNS + "type")
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+var readTests = []struct {
+	name   string
+	data   string
+	expect []quad.Quad
+}{
+	{
+		name: "descriptions",
+		data: `<?xml version="1.0"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xmlns:ex="http://example.org/" xml:base="http://example.org/">
+	<rdf:Description rdf:about="alice" ex:name="Alice">
+		<ex:knows rdf:resource="#bob"/>
+		<ex:age rdf:datatype="http://www.w3.org/2001/XMLSchema#integer">42</ex:age>
+		<ex:title xml:lang="en">Dr</ex:title>
+		<ex:friend rdf:nodeID="carol"/>
+	</rdf:Description>
+	<ex:Person rdf:nodeID="carol" rdf:type="http://example.org/Agent"/>
+</rdf:RDF>`,
+		expect: []quad.Quad{
+			{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String("Alice")},
+			{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("#bob")},
+			{Subject: iri("alice"), Predicate: iri("age"), Object: quad.Int(42)},
+			{Subject: iri("alice"), Predicate: iri("title"), Object: quad.LangString{Value: "Dr", Lang: "en"}},
+			{Subject: iri("alice"), Predicate: iri("friend"), Object: quad.BNode("carol")},
+			{Subject: quad.BNode("carol"), Predicate: rdfType, Object: iri("Person")},
+			{Subject: quad.BNode("carol"), Predicate: rdfType, Object: iri("Agent")},
+		},
+	},
+	{
+		name: "nested nodes",
+		data: `<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns:ex="http://example.org/" xml:lang="fr">
+	<rdf:Description rdf:about="http://example.org/a">
+		<ex:p>
+			<ex:Thing ex:label="chose"/>
+		</ex:p>
+		<ex:q rdf:parseType="Resource">
+			<ex:r>x</ex:r>
+		</ex:q>
+		<ex:s ex:t="y" xml:lang=""/>
+		<rdf:li>one</rdf:li>
+		<rdf:li>two</rdf:li>
+	</rdf:Description>
+</rdf:RDF>`,
+		expect: []quad.Quad{
+			{Subject: iri("a"), Predicate: iri("p"), Object: quad.BNode("n1")},
+			{Subject: quad.BNode("n1"), Predicate: rdfType, Object: iri("Thing")},
+			{Subject: quad.BNode("n1"), Predicate: iri("label"), Object: quad.LangString{Value: "chose", Lang: "fr"}},
+			{Subject: iri("a"), Predicate: iri("q"), Object: quad.BNode("n2")},
+			{Subject: quad.BNode("n2"), Predicate: iri("r"), Object: quad.LangString{Value: "x", Lang: "fr"}},
+			{Subject: iri("a"), Predicate: iri("s"), Object: quad.BNode("n3")},
+			{Subject: quad.BNode("n3"), Predicate: iri("t"), Object: quad.String("y")},
+			{Subject: iri("a"), Predicate: quad.IRI(rdfNS + "_1"), Object: quad.LangString{Value: "one", Lang: "fr"}},
+			{Subject: iri("a"), Predicate: quad.IRI(rdfNS + "_2"), Object: quad.LangString{Value: "two", Lang: "fr"}},
+		},
+	},
+	{
+		name: "parse types",
+		data: `<!DOCTYPE rdf:RDF [
+	<!ENTITY ex "http://example.org/">
+]>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns:ex="http://example.org/">
+	<rdf:Description rdf:about="&ex;a">
+		<ex:list rdf:parseType="Collection">
+			<rdf:Description rdf:about="&ex;b"/>
+			<rdf:Description rdf:about="&ex;c"/>
+		</ex:list>
+		<ex:empty rdf:parseType="Collection"/>
+		<ex:xml rdf:parseType="Literal"><b>bold</b> text</ex:xml>
+	</rdf:Description>
+</rdf:RDF>`,
+		expect: []quad.Quad{
+			{Subject: iri("a"), Predicate: iri("list"), Object: quad.BNode("n1")},
+			{Subject: quad.BNode("n1"), Predicate: quad.IRI(rdfNS + "first"), Object: iri("b")},
+			{Subject: quad.BNode("n1"), Predicate: quad.IRI(rdfNS + "rest"), Object: quad.BNode("n2")},
+			{Subject: quad.BNode("n2"), Predicate: quad.IRI(rdfNS + "first"), Object: iri("c")},
+			{Subject: quad.BNode("n2"), Predicate: quad.IRI(rdfNS + "rest"), Object: quad.IRI(rdfNS + "nil")},
+			{Subject: iri("a"), Predicate: iri("empty"), Object: quad.IRI(rdfNS + "nil")},
+			{Subject: iri("a"), Predicate: iri("xml"), Object: quad.TypedString{Value: "<b>bold</b> text", Type: rdfNS + "XMLLiteral"}},
+		},
+	},
+	{
+		name: "reification",
+		data: `<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns:ex="http://example.org/" xml:base="http://example.org/doc">
+	<ex:Doc rdf:ID="d">
+		<ex:p rdf:ID="st">v</ex:p>
+	</ex:Doc>
+</rdf:RDF>`,
+		expect: []quad.Quad{
+			{Subject: iri("doc#d"), Predicate: rdfType, Object: iri("Doc")},
+			{Subject: iri("doc#d"), Predicate: iri("p"), Object: quad.String("v")},
+			{Subject: iri("doc#st"), Predicate: rdfType, Object: quad.IRI(rdfNS + "Statement")},
+			{Subject: iri("doc#st"), Predicate: quad.IRI(rdfNS + "subject"), Object: iri("doc#d")},
+			{Subject: iri("doc#st"), Predicate: quad.IRI(rdfNS + "predicate"), Object: iri("p")},
+			{Subject: iri("doc#st"), Predicate: quad.IRI(rdfNS + "object"), Object: quad.String("v")},
+		},
+	},
+	{
+		name: "root node element",
+		data: `<ex:Thing xmlns:ex="http://example.org/" xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" rdf:about="http://example.org/x"/>`,
+		expect: []quad.Quad{
+			{Subject: iri("x"), Predicate: rdfType, Object: iri("Thing")},
+		},
+	},
+}
+
+// generated matches random prefixes of generated blank nodes.
+var generated = regexp.MustCompile(`^g[0-9a-f]{8}_`)
+
+// stripGenerated removes random prefixes of generated blank nodes, so quads can be compared.
+func stripGenerated(quads []quad.Quad) []quad.Quad {
+	strip := func(v quad.Value) quad.Value {
+		if b, ok := v.(quad.BNode); ok {
+			return quad.BNode(generated.ReplaceAllString(string(b), ""))
+		}
+		return v
+	}
+	for i, q := range quads {
+		quads[i] = quad.Quad{Subject: strip(q.Subject), Predicate: q.Predicate, Object: strip(q.Object), Label: strip(q.Label)}
+	}
+	return quads
+}
+
+func TestReader(t *testing.T) {
+	for _, c := range readTests {
+		t.Run(c.name, func(t *testing.T) {
+			r := rdfxml.NewReader(strings.NewReader(c.data))
+			defer r.Close()
+			quads, err := quad.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, c.expect, stripGenerated(quads))
+		})
+	}
+}
+
+func TestReaderBlankNodeLabels(t *testing.T) {
+	const data = `<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#" xmlns:ex="http://example.org/">
+	<rdf:Description rdf:nodeID="n1">
+		<ex:p><rdf:Description ex:q="1"/></ex:p>
+		<ex:list rdf:parseType="Collection"><rdf:Description rdf:nodeID="n2"/></ex:list>
+	</rdf:Description>
+</rdf:RDF>`
+	quads, err := quad.ReadAll(rdfxml.NewReader(strings.NewReader(data)))
+	require.NoError(t, err)
+	require.Len(t, quads, 5)
+	require.Equal(t, quad.BNode("n1"), quads[0].Subject)
+	require.NotEqual(t, quads[0].Subject, quads[0].Object)
+	require.Equal(t, quads[0].Object, quads[1].Subject)
+	require.NotEqual(t, quad.BNode("n2"), quads[2].Object)
+	require.Equal(t, quad.BNode("n2"), quads[3].Object)
+}
+
+func TestReaderErrors(t *testing.T) {
+	_, err := quad.ReadAll(rdfxml.NewReader(strings.NewReader(`<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">
+	<rdf:Description>text</rdf:Description>
+</rdf:RDF>`)))
+	require.EqualError(t, err, `line 2: unexpected text: "text"`)
+
+	_, err = quad.ReadAll(rdfxml.NewReader(strings.NewReader(`<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#">`)))
+	require.EqualError(t, err, `XML syntax error on line 1: unexpected EOF`)
+}
+
+func TestWriter(t *testing.T) {
+	quads := []quad.Quad{
+		{Subject: iri("alice"), Predicate: rdfType, Object: iri("Person")},
+		{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String(`Alice & "Bob"`)},
+		{Subject: iri("alice"), Predicate: iri("title"), Object: quad.LangString{Value: "Dr", Lang: "en"}},
+		{Subject: iri("alice"), Predicate: iri("age"), Object: quad.Int(42)},
+		{Subject: iri("alice"), Predicate: iri("born"), Object: quad.TypedString{Value: "1990-07-04", Type: "http://www.w3.org/2001/XMLSchema#date"}},
+		{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")},
+		{Subject: quad.BNode("bob"), Predicate: quad.IRI("http://other.org/v1/name"), Object: quad.String("Bob")},
+	}
+	const data = `<?xml version="1.0" encoding="UTF-8"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xmlns:ex="http://example.org/">
+	<rdf:Description rdf:about="http://example.org/alice">
+		<rdf:type rdf:resource="http://example.org/Person"/>
+		<ex:name>Alice &amp; &#34;Bob&#34;</ex:name>
+		<ex:title xml:lang="en">Dr</ex:title>
+		<ex:age rdf:datatype="http://www.w3.org/2001/XMLSchema#integer">42</ex:age>
+		<ex:born rdf:datatype="http://www.w3.org/2001/XMLSchema#date">1990-07-04</ex:born>
+		<ex:knows rdf:nodeID="bob"/>
+	</rdf:Description>
+	<rdf:Description rdf:nodeID="bob">
+		<ns0:name xmlns:ns0="http://other.org/v1/">Bob</ns0:name>
+	</rdf:Description>
+</rdf:RDF>
+`
+	var ns voc.Namespaces
+	ns.Register(voc.Namespace{Prefix: "ex:", Full: "http://example.org/"})
+	ns.Register(voc.Namespace{Prefix: "rdf:", Full: rdfNS})
+
+	buf := bytes.NewBuffer(nil)
+	w := rdfxml.NewWriter(buf, &ns)
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, data, buf.String())
+
+	got, err := quad.ReadAll(rdfxml.NewReader(buf))
+	require.NoError(t, err)
+	require.Equal(t, quads, got)
+}
+
+func TestWriterQName(t *testing.T) {
+	w := rdfxml.NewWriter(io.Discard, nil)
+	err := w.WriteQuad(quad.MakeIRI("http://example.org/a", "http://example.org/123", "http://example.org/b", ""))
+	require.EqualError(t, err, `predicate <http://example.org/123> cannot be represented as an XML QName`)
+}
+
+func TestWriterShortIRIs(t *testing.T) {
+	var ns voc.Namespaces
+	ns.Register(voc.Namespace{Prefix: "rdfs:", Full: rdfs.NS})
+	buf := bytes.NewBuffer(nil)
+	w := rdfxml.NewWriter(buf, &ns)
+	require.NoError(t, w.WriteQuad(quad.Quad{Subject: quad.IRI(rdfs.Class), Predicate: quad.IRI(rdf.Type), Object: quad.IRI(rdfs.Class)}))
+	require.NoError(t, w.WriteQuad(quad.Quad{Subject: quad.IRI(rdfs.Class), Predicate: quad.IRI(rdfs.Label), Object: quad.String("Class")}))
+	require.NoError(t, w.Close())
+	require.Equal(t, `<?xml version="1.0" encoding="UTF-8"?>
+<rdf:RDF xmlns:rdf="http://www.w3.org/1999/02/22-rdf-syntax-ns#"
+	xmlns:rdfs="http://www.w3.org/2000/01/rdf-schema#">
+	<rdf:Description rdf:about="http://www.w3.org/2000/01/rdf-schema#Class">
+		<rdf:type rdf:resource="http://www.w3.org/2000/01/rdf-schema#Class"/>
+		<rdfs:label>Class</rdfs:label>
+	</rdf:Description>
+</rdf:RDF>
+`, buf.String())
+}
+
+func TestWriterNodeID(t *testing.T) {
+	for _, q := range []quad.Quad{
+		{Subject: quad.BNode("1a"), Predicate: iri("p"), Object: iri("o")},
+		{Subject: iri("s"), Predicate: iri("p"), Object: quad.BNode("a b")},
+	} {
+		w := rdfxml.NewWriter(io.Discard, nil)
+		require.Error(t, w.WriteQuad(q), "%v", q)
+	}
+}
@@ -0,0 +1,430 @@
+package rdfxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/bnode"
+	"github.com/cayleygraph/quad/voc/rdf"
+)
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+type frameKind int
+
+const (
+	rootFrame       frameKind = iota // rdf:RDF element
+	nodeFrame                        // node element, or a property with rdf:parseType="Resource"
+	propFrame                        // property element with a literal or a node element as a content
+	emptyPropFrame                   // property element without any content
+	literalFrame                     // property element with rdf:parseType="Literal"
+	collectionFrame                  // property element with rdf:parseType="Collection"
+)
+
+type frame struct {
+	kind frameKind
+	base *url.URL
+	lang string
+
+	subject quad.Value // node for node frames, or a subject of property frames
+	li      int        // last index of rdf:li properties
+
+	pred     quad.IRI
+	reify    quad.IRI // statement IRI from rdf:ID of property element
+	datatype quad.IRI
+	object   quad.Value // node element used as a property value
+	text     strings.Builder
+	items    []quad.Value // collection items
+
+	depth int // nesting level inside XML literal
+	buf   *bytes.Buffer
+	enc   *xml.Encoder
+}
+
+// Reader implements RDF/XML document parsing according to the RDF 1.1 XML Syntax specification.
+//
+// Reader streams XML tokens and never builds a complete document tree in memory.
+type Reader struct {
+	dec   *xml.Decoder
+	stack []*frame
+	seq   bnode.Generator
+
+	buf []quad.Quad
+	cur int
+	err error
+}
+
+// NewReader returns an RDF/XML decoder that takes its input from the provided io.Reader.
+func NewReader(r io.Reader) *Reader {
+	dec := xml.NewDecoder(r)
+	dec.Entity = make(map[string]string)
+	return &Reader{dec: dec}
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	for r.cur >= len(r.buf) {
+		if r.err != nil {
+			return quad.Quad{}, r.err
+		}
+		r.buf, r.cur = r.buf[:0], 0
+		r.err = r.next()
+	}
+	q := r.buf[r.cur]
+	r.cur++
+	return q, nil
+}
+
+// Close implements quad.ReadCloser.
+func (r *Reader) Close() error { return nil }
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	line, _ := r.dec.InputPos()
+	return fmt.Errorf("line %d: "+format, append([]interface{}{line}, args...)...)
+}
+
+func (r *Reader) emit(s, p, o quad.Value) {
+	r.buf = append(r.buf, quad.Quad{Subject: s, Predicate: p, Object: o})
+}
+
+// statement emits a triple, and reifies it, if rdf:ID was set on a property element.
+func (r *Reader) statement(s, p, o quad.Value, reify quad.IRI) {
+	r.emit(s, p, o)
+	if reify != "" {
+		r.emit(reify, rdfType, rdfStatement)
+		r.emit(reify, rdfSubject, s)
+		r.emit(reify, rdfPredicate, p)
+		r.emit(reify, rdfObject, o)
+	}
+}
+
+func (r *Reader) top() *frame {
+	if len(r.stack) == 0 {
+		return nil
+	}
+	return r.stack[len(r.stack)-1]
+}
+
+// next processes a single XML token.
+func (r *Reader) next() error {
+	tok, err := r.dec.Token()
+	if err == io.EOF && len(r.stack) != 0 {
+		return r.errorf("%w", io.ErrUnexpectedEOF)
+	} else if err != nil {
+		return err
+	}
+	top := r.top()
+	if top != nil && top.kind == literalFrame {
+		return r.literal(top, tok)
+	}
+	switch tok := tok.(type) {
+	case xml.StartElement:
+		return r.startElement(top, tok)
+	case xml.EndElement:
+		return r.endElement(top)
+	case xml.CharData:
+		if top == nil {
+			return nil
+		}
+		switch top.kind {
+		case propFrame:
+			top.text.Write(tok)
+		default:
+			if len(bytes.TrimSpace(tok)) != 0 {
+				return r.errorf("unexpected text: %q", string(tok))
+			}
+		}
+	case xml.Directive:
+		r.entities(tok)
+	}
+	return nil
+}
+
+var reEntity = regexp.MustCompile(`<!ENTITY\s+([^\s%]+)\s+(?:"([^"]*)"|'([^']*)')\s*>`)
+
+// entities registers entities declared in the document type definition.
+func (r *Reader) entities(dir xml.Directive) {
+	for _, m := range reEntity.FindAllSubmatch(dir, -1) {
+		r.dec.Entity[string(m[1])] = string(m[2]) + string(m[3])
+	}
+}
+
+func isRDF(name xml.Name, local string) bool {
+	return name.Space == rdf.NS && name.Local == local
+}
+
+// newFrame creates a frame that inherits base IRI and language from the parent, and applies xml:base and xml:lang.
+func (r *Reader) newFrame(parent *frame, kind frameKind, e xml.StartElement) (*frame, error) {
+	f := &frame{kind: kind}
+	if parent != nil {
+		f.base, f.lang = parent.base, parent.lang
+	}
+	for _, a := range e.Attr {
+		if a.Name.Space != xmlNS {
+			continue
+		}
+		switch a.Name.Local {
+		case "lang":
+			f.lang = a.Value
+		case "base":
+			base, err := url.Parse(string(resolve(f.base, a.Value)))
+			if err != nil {
+				return nil, r.errorf("invalid base IRI: %v", err)
+			}
+			base.Fragment = ""
+			f.base = base
+		}
+	}
+	return f, nil
+}
+
+// isSyntaxAttr checks if an attribute should not be interpreted as a property attribute.
+func isSyntaxAttr(a xml.Attr) bool {
+	switch a.Name.Space {
+	case "", "xmlns", xmlNS:
+		return true
+	}
+	return false
+}
+
+func (r *Reader) startElement(top *frame, e xml.StartElement) error {
+	if top == nil {
+		if isRDF(e.Name, "RDF") {
+			f, err := r.newFrame(nil, rootFrame, e)
+			if err != nil {
+				return err
+			}
+			r.stack = append(r.stack, f)
+			return nil
+		}
+		return r.nodeElement(nil, e)
+	}
+	switch top.kind {
+	case rootFrame, propFrame, collectionFrame:
+		return r.nodeElement(top, e)
+	case nodeFrame:
+		return r.propertyElement(top, e)
+	}
+	return r.errorf("unexpected element: %s", e.Name.Local)
+}
+
+func (r *Reader) endElement(top *frame) error {
+	if top == nil {
+		return r.errorf("unexpected end element")
+	}
+	r.stack = r.stack[:len(r.stack)-1]
+	switch top.kind {
+	case propFrame:
+		if top.object != nil {
+			return nil
+		}
+		var o quad.Value
+		switch {
+		case top.datatype != "":
+			o = typed(top.text.String(), top.datatype)
+		case top.lang != "":
+			o = quad.LangString{Value: quad.String(top.text.String()), Lang: top.lang}
+		default:
+			o = quad.String(top.text.String())
+		}
+		r.statement(top.subject, top.pred, o, top.reify)
+	case collectionFrame:
+		if len(top.items) == 0 {
+			r.statement(top.subject, top.pred, rdfNil, top.reify)
+			return nil
+		}
+		head := r.seq.Next()
+		r.statement(top.subject, top.pred, head, top.reify)
+		node := head
+		for i, it := range top.items {
+			r.emit(node, rdfFirst, it)
+			if i == len(top.items)-1 {
+				r.emit(node, rdfRest, rdfNil)
+			} else {
+				next := r.seq.Next()
+				r.emit(node, rdfRest, next)
+				node = next
+			}
+		}
+	}
+	return nil
+}
+
+// nodeElement processes the start of a node element and emits all triples known at this point.
+func (r *Reader) nodeElement(parent *frame, e xml.StartElement) error {
+	f, err := r.newFrame(parent, nodeFrame, e)
+	if err != nil {
+		return err
+	}
+	var s quad.Value
+	for _, a := range e.Attr {
+		switch {
+		case isRDF(a.Name, "about"):
+			s = resolve(f.base, a.Value)
+		case isRDF(a.Name, "ID"):
+			s = resolve(f.base, "#"+a.Value)
+		case isRDF(a.Name, "nodeID"):
+			s = quad.BNode(a.Value)
+		}
+	}
+	if s == nil {
+		s = r.seq.Next()
+	}
+	f.subject = s
+	if parent != nil {
+		switch parent.kind {
+		case propFrame:
+			if parent.object != nil {
+				return r.errorf("multiple node elements in a property element")
+			}
+			parent.object = s
+			r.statement(parent.subject, parent.pred, s, parent.reify)
+		case collectionFrame:
+			parent.items = append(parent.items, s)
+		}
+	}
+	if !isRDF(e.Name, "Description") {
+		r.emit(s, rdfType, quad.IRI(e.Name.Space+e.Name.Local))
+	}
+	for _, a := range e.Attr {
+		if isSyntaxAttr(a) {
+			continue
+		}
+		switch {
+		case isRDF(a.Name, "about"), isRDF(a.Name, "ID"), isRDF(a.Name, "nodeID"):
+		case isRDF(a.Name, "type"):
+			r.emit(s, rdfType, resolve(f.base, a.Value))
+		default:
+			r.emit(s, quad.IRI(a.Name.Space+a.Name.Local), f.plain(a.Value))
+		}
+	}
+	r.stack = append(r.stack, f)
+	return nil
+}
+
+// propertyElement processes the start of a property element.
+func (r *Reader) propertyElement(parent *frame, e xml.StartElement) error {
+	f, err := r.newFrame(parent, propFrame, e)
+	if err != nil {
+		return err
+	}
+	f.subject = parent.subject
+	if isRDF(e.Name, "li") {
+		parent.li++
+		f.pred = quad.IRI(rdf.NS + "_" + strconv.Itoa(parent.li))
+	} else {
+		f.pred = quad.IRI(e.Name.Space + e.Name.Local)
+	}
+	var (
+		obj       quad.Value
+		parseType string
+		hasType   bool
+		props     []xml.Attr
+	)
+	for _, a := range e.Attr {
+		if isSyntaxAttr(a) {
+			continue
+		}
+		switch {
+		case isRDF(a.Name, "resource"):
+			obj = resolve(f.base, a.Value)
+		case isRDF(a.Name, "nodeID"):
+			obj = quad.BNode(a.Value)
+		case isRDF(a.Name, "datatype"):
+			f.datatype = resolve(f.base, a.Value)
+		case isRDF(a.Name, "parseType"):
+			parseType, hasType = a.Value, true
+		case isRDF(a.Name, "ID"):
+			f.reify = resolve(f.base, "#"+a.Value)
+		default:
+			props = append(props, a)
+		}
+	}
+	switch {
+	case hasType && parseType == "Resource":
+		b := r.seq.Next()
+		r.statement(f.subject, f.pred, b, f.reify)
+		f.kind = nodeFrame
+		f.subject = b
+	case hasType && parseType == "Collection":
+		f.kind = collectionFrame
+	case hasType:
+		// all unknown parse types are treated as literals
+		f.kind = literalFrame
+		f.buf = new(bytes.Buffer)
+		f.enc = xml.NewEncoder(f.buf)
+	case obj != nil || len(props) != 0:
+		if obj == nil {
+			obj = r.seq.Next()
+		}
+		r.statement(f.subject, f.pred, obj, f.reify)
+		for _, a := range props {
+			if isRDF(a.Name, "type") {
+				r.emit(obj, rdfType, resolve(f.base, a.Value))
+			} else {
+				r.emit(obj, quad.IRI(a.Name.Space+a.Name.Local), f.plain(a.Value))
+			}
+		}
+		f.kind = emptyPropFrame
+	}
+	r.stack = append(r.stack, f)
+	return nil
+}
+
+// literal processes tokens inside a property with rdf:parseType="Literal".
+func (r *Reader) literal(f *frame, tok xml.Token) error {
+	switch tok := tok.(type) {
+	case xml.StartElement:
+		f.depth++
+		attr := tok.Attr[:0:0]
+		for _, a := range tok.Attr {
+			if a.Name.Space != "xmlns" && a.Name.Local != "xmlns" {
+				attr = append(attr, a)
+			}
+		}
+		tok.Attr = attr
+		return f.enc.EncodeToken(tok)
+	case xml.EndElement:
+		if f.depth == 0 {
+			if err := f.enc.Flush(); err != nil {
+				return err
+			}
+			r.stack = r.stack[:len(r.stack)-1]
+			r.statement(f.subject, f.pred, quad.TypedString{
+				Value: quad.String(f.buf.String()),
+				Type:  rdfXMLLiteral,
+			}, f.reify)
+			return nil
+		}
+		f.depth--
+		return f.enc.EncodeToken(tok)
+	case xml.CharData:
+		return f.enc.EncodeToken(tok)
+	}
+	return nil
+}
+
+// plain returns a literal with a language of the frame.
+func (f *frame) plain(s string) quad.Value {
+	if f.lang != "" {
+		return quad.LangString{Value: quad.String(s), Lang: f.lang}
+	}
+	return quad.String(s)
+}
+
+func typed(s string, dt quad.IRI) quad.Value {
+	v := quad.TypedString{Value: quad.String(s), Type: dt}
+	if AutoConvertTypedString {
+		if nv, err := v.ParseValue(); err == nil {
+			return nv
+		}
+	}
+	return v
+}
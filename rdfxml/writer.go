@@ -0,0 +1,245 @@
+package rdfxml
+
+import (
+	"bufio"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/voc"
+	"github.com/cayleygraph/quad/voc/rdf"
+)
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns an RDF/XML encoder that writes its output to the provided io.Writer.
+//
+// Namespaces from ns, or from the global voc registry if ns is nil, are declared on the root element
+// and are used to split predicate IRIs into QNames. Predicates from other namespaces are written
+// with a namespace declared on the property element itself.
+func NewWriter(w io.Writer, ns *voc.Namespaces) *Writer {
+	var list []voc.Namespace
+	if ns != nil {
+		list = ns.List()
+	} else {
+		list = voc.List()
+	}
+	return &Writer{bw: bufio.NewWriter(w), ns: newNamespaces(list)}
+}
+
+// Writer implements RDF/XML document generator according to the RDF 1.1 XML Syntax specification.
+//
+// Consecutive quads with the same subject are grouped into a single rdf:Description element.
+// Quad labels are ignored, since RDF/XML has no support for named graphs.
+type Writer struct {
+	bw      *bufio.Writer
+	ns      []voc.Namespace
+	written bool
+	s       quad.Value
+	err     error
+}
+
+// newNamespaces filters namespaces that can be declared in XML and sorts them from the longest to the shortest IRI.
+// The rdf namespace is always included.
+func newNamespaces(list []voc.Namespace) []voc.Namespace {
+	out := make([]voc.Namespace, 0, len(list)+1)
+	out = append(out, voc.Namespace{Prefix: "rdf", Full: rdf.NS})
+	for _, ns := range list {
+		pref := strings.TrimSuffix(ns.Prefix, ":")
+		if ns.Full == "" || pref == "rdf" || strings.HasPrefix(strings.ToLower(pref), "xml") || !isNCName(pref) {
+			continue
+		}
+		out = append(out, voc.Namespace{Prefix: pref, Full: ns.Full})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if len(out[i].Full) != len(out[j].Full) {
+			return len(out[i].Full) > len(out[j].Full)
+		}
+		return out[i].Prefix < out[j].Prefix
+	})
+	return out
+}
+
+func (w *Writer) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.bw.WriteString(s)
+}
+
+func (w *Writer) writeHeader() {
+	w.written = true
+	w.writeString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	w.writeString(`<rdf:RDF xmlns:rdf="` + rdf.NS + `"`)
+	list := make([]voc.Namespace, len(w.ns))
+	copy(list, w.ns)
+	sort.Slice(list, func(i, j int) bool { return list[i].Prefix < list[j].Prefix })
+	for _, ns := range list {
+		if ns.Prefix == "rdf" {
+			continue
+		}
+		w.writeString("\n\txmlns:" + ns.Prefix + `="` + escape(ns.Full) + `"`)
+	}
+	w.writeString(">\n")
+}
+
+// qname splits predicate IRI into a QName. It returns the QName and namespace declaration, if it's required.
+func (w *Writer) qname(p quad.IRI) (string, string, error) {
+	s := string(p.Full())
+	for _, ns := range w.ns {
+		if strings.HasPrefix(s, ns.Full) && isNCName(s[len(ns.Full):]) {
+			return ns.Prefix + ":" + s[len(ns.Full):], "", nil
+		}
+	}
+	i := len(s)
+	for i > 0 {
+		c, n := utf8.DecodeLastRuneInString(s[:i])
+		if !isNameChar(c) {
+			break
+		}
+		i -= n
+	}
+	for i < len(s) {
+		c, n := utf8.DecodeRuneInString(s[i:])
+		if isNameStart(c) {
+			break
+		}
+		i += n
+	}
+	if i >= len(s) || i == 0 {
+		return "", "", fmt.Errorf("predicate %v cannot be represented as an XML QName", p)
+	}
+	return "ns0:" + s[i:], ` xmlns:ns0="` + escape(s[:i]) + `"`, nil
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	}
+	pred, ok := q.Predicate.(quad.IRI)
+	if !ok {
+		return fmt.Errorf("unsupported predicate value: %v", q.Predicate)
+	}
+	name, decl, err := w.qname(pred)
+	if err != nil {
+		return err
+	} else if b, ok := q.Object.(quad.BNode); ok && !isNCName(string(b)) {
+		return fmt.Errorf("blank node %v cannot be represented as rdf:nodeID", b)
+	}
+	if !w.written {
+		w.writeHeader()
+	}
+	if q.Subject != w.s {
+		var about string
+		switch s := q.Subject.(type) {
+		case quad.IRI:
+			about = `rdf:about="` + escape(string(s.Full())) + `"`
+		case quad.BNode:
+			if !isNCName(string(s)) {
+				return fmt.Errorf("blank node %v cannot be represented as rdf:nodeID", s)
+			}
+			about = `rdf:nodeID="` + escape(string(s)) + `"`
+		default:
+			return fmt.Errorf("unsupported subject value: %v", q.Subject)
+		}
+		if w.s != nil {
+			w.writeString("\t</rdf:Description>\n")
+		}
+		w.writeString("\t<rdf:Description " + about + ">\n")
+		w.s = q.Subject
+	}
+	w.writeString("\t\t<" + name + decl)
+	w.writeObject(name, q.Object)
+	return w.err
+}
+
+// writeObject writes the rest of property element, including attributes, value and a closing tag.
+func (w *Writer) writeObject(name string, v quad.Value) {
+	if ts, ok := v.(quad.TypedStringer); ok {
+		s := ts.TypedString()
+		s.Type = s.Type.Full()
+		v = s
+	}
+	switch v := v.(type) {
+	case quad.IRI:
+		w.writeString(` rdf:resource="` + escape(string(v.Full())) + `"/>` + "\n")
+		return
+	case quad.BNode:
+		w.writeString(` rdf:nodeID="` + escape(string(v)) + `"/>` + "\n")
+		return
+	case quad.String:
+		w.writeString(">" + escape(string(v)))
+	case quad.LangString:
+		w.writeString(` xml:lang="` + escape(v.Lang) + `">` + escape(string(v.Value)))
+	case quad.TypedString:
+		if v.Type == rdfXMLLiteral {
+			w.writeString(` rdf:parseType="Literal">` + string(v.Value))
+		} else {
+			w.writeString(` rdf:datatype="` + escape(string(v.Type)) + `">` + escape(string(v.Value)))
+		}
+	default:
+		w.writeString(">" + escape(v.String()))
+	}
+	w.writeString("</" + name + ">\n")
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+// Close finishes the document and flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if !w.written {
+		w.writeHeader()
+	}
+	if w.s != nil {
+		w.writeString("\t</rdf:Description>\n")
+		w.s = nil
+	}
+	w.writeString("</rdf:RDF>\n")
+	if w.err == nil {
+		w.err = w.bw.Flush()
+	}
+	return w.err
+}
+
+func escape(s string) string {
+	var sb strings.Builder
+	xml.EscapeText(&sb, []byte(s))
+	return sb.String()
+}
+
+func isNameStart(c rune) bool {
+	return c == '_' || unicode.IsLetter(c)
+}
+
+func isNameChar(c rune) bool {
+	return isNameStart(c) || c == '-' || c == '.' || unicode.IsDigit(c)
+}
+
+// isNCName checks if a string is a valid XML name without colons.
+func isNCName(s string) bool {
+	for i, c := range s {
+		if i == 0 && !isNameStart(c) || !isNameChar(c) {
+			return false
+		}
+	}
+	return s != ""
+}
@@ -0,0 +1,313 @@
+// Package trix provides an encoder/decoder for TriX (Triples in XML) format.
+//
+// See https://www.hpl.hp.com/techreports/2004/HPL-2004-56.html for the format definition.
+package trix
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+
+	"github.com/cayleygraph/quad"
+)
+
+// AutoConvertTypedString allows to convert TypedString values to native
+// equivalents directly while parsing. It will call ToNative on all TypedString values.
+//
+// If conversion error occurs, it will preserve original TypedString value.
+var AutoConvertTypedString = true
+
+// NS is the XML namespace of TriX documents.
+const NS = "http://www.w3.org/2004/03/trix/trix-1/"
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "trix",
+		Ext:    []string{".trix"},
+		Mime:   []string{"application/trix"},
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w) },
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r) },
+		Sniff:  sniff,
+	})
+}
+
+// sniff checks the root element of an XML document.
+func sniff(head []byte) bool {
+	dec := xml.NewDecoder(bytes.NewReader(head))
+	for {
+		tok, err := dec.Token()
+		if err != nil {
+			return false
+		} else if el, ok := tok.(xml.StartElement); ok {
+			return el.Name.Space == NS && el.Name.Local == "TriX"
+		}
+	}
+}
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+// Reader implements TriX document parsing. Graph names are returned as quad labels.
+//
+// Reader streams XML tokens and only decodes a single term element at a time.
+type Reader struct {
+	dec *xml.Decoder
+
+	inGraph  bool
+	inTriple bool
+	named    bool // graph name can no longer appear
+	label    quad.Value
+	terms    []quad.Value
+	err      error
+}
+
+// NewReader returns a TriX decoder that takes its input from the provided io.Reader.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{dec: xml.NewDecoder(r)}
+}
+
+type term struct {
+	XMLName  xml.Name
+	Lang     string `xml:"http://www.w3.org/XML/1998/namespace lang,attr"`
+	Datatype string `xml:"datatype,attr"`
+	Value    string `xml:",chardata"`
+}
+
+func (r *Reader) errorf(format string, args ...interface{}) error {
+	line, _ := r.dec.InputPos()
+	return fmt.Errorf("line %d: "+format, append([]interface{}{line}, args...)...)
+}
+
+// term decodes a single term element.
+func (r *Reader) term(start xml.StartElement) (quad.Value, error) {
+	var t term
+	if err := r.dec.DecodeElement(&t, &start); err != nil {
+		return nil, err
+	}
+	switch t.XMLName.Local {
+	case "uri":
+		return quad.IRI(t.Value), nil
+	case "id":
+		return quad.BNode(t.Value), nil
+	case "plainLiteral":
+		if t.Lang != "" {
+			return quad.LangString{Value: quad.String(t.Value), Lang: t.Lang}, nil
+		}
+		return quad.String(t.Value), nil
+	case "typedLiteral":
+		v := quad.TypedString{Value: quad.String(t.Value), Type: quad.IRI(t.Datatype)}
+		if AutoConvertTypedString {
+			if nv, err := v.ParseValue(); err == nil {
+				return nv, nil
+			}
+		}
+		return v, nil
+	}
+	return nil, r.errorf("unexpected element: %s", t.XMLName.Local)
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	if r.err != nil {
+		return quad.Quad{}, r.err
+	}
+	q, err := r.readQuad()
+	if err == io.EOF && (r.inGraph || r.inTriple) {
+		err = r.errorf("%w", io.ErrUnexpectedEOF)
+	}
+	r.err = err
+	return q, err
+}
+
+func (r *Reader) readQuad() (quad.Quad, error) {
+	for {
+		tok, err := r.dec.Token()
+		if err != nil {
+			return quad.Quad{}, err
+		}
+		switch tok := tok.(type) {
+		case xml.StartElement:
+			switch {
+			case r.inTriple:
+				v, err := r.term(tok)
+				if err != nil {
+					return quad.Quad{}, err
+				}
+				r.terms = append(r.terms, v)
+			case r.inGraph && tok.Name.Local == "triple":
+				r.inTriple, r.named = true, true
+				r.terms = r.terms[:0]
+			case r.inGraph:
+				if r.named {
+					return quad.Quad{}, r.errorf("unexpected element: %s", tok.Name.Local)
+				}
+				if r.label, err = r.term(tok); err != nil {
+					return quad.Quad{}, err
+				}
+				r.named = true
+			case tok.Name.Local == "graph":
+				r.inGraph, r.named, r.label = true, false, nil
+			case tok.Name.Local != "TriX":
+				return quad.Quad{}, r.errorf("unexpected element: %s", tok.Name.Local)
+			}
+		case xml.EndElement:
+			switch {
+			case r.inTriple:
+				r.inTriple = false
+				if len(r.terms) != 3 {
+					return quad.Quad{}, r.errorf("expected 3 terms in a triple, got %d", len(r.terms))
+				}
+				return quad.Quad{
+					Subject:   r.terms[0],
+					Predicate: r.terms[1],
+					Object:    r.terms[2],
+					Label:     r.label,
+				}, nil
+			case r.inGraph:
+				r.inGraph, r.label = false, nil
+			}
+		}
+	}
+}
+
+// Close implements quad.ReadCloser.
+func (r *Reader) Close() error { return nil }
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns a TriX encoder that writes its output to the provided io.Writer.
+func NewWriter(w io.Writer) *Writer {
+	return &Writer{w: bufio.NewWriter(w)}
+}
+
+// Writer implements TriX document generator.
+//
+// Consecutive quads with the same label are grouped into a single graph element.
+type Writer struct {
+	w       *bufio.Writer
+	written bool
+	inGraph bool
+	label   quad.Value
+	err     error
+}
+
+func (w *Writer) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.w.WriteString(s)
+}
+
+func (w *Writer) writeEscaped(s string) {
+	if w.err != nil {
+		return
+	}
+	w.err = xml.EscapeText(w.w, []byte(s))
+}
+
+// writeTerm writes a single term element.
+func (w *Writer) writeTerm(indent string, v quad.Value) {
+	if ts, ok := v.(quad.TypedStringer); ok {
+		s := ts.TypedString()
+		s.Type = s.Type.Full()
+		v = s
+	}
+	w.writeString(indent)
+	switch v := v.(type) {
+	case quad.IRI:
+		w.writeString("<uri>")
+		w.writeEscaped(string(v))
+		w.writeString("</uri>\n")
+	case quad.BNode:
+		w.writeString("<id>")
+		w.writeEscaped(string(v))
+		w.writeString("</id>\n")
+	case quad.String:
+		w.writeString("<plainLiteral>")
+		w.writeEscaped(string(v))
+		w.writeString("</plainLiteral>\n")
+	case quad.LangString:
+		w.writeString(`<plainLiteral xml:lang="`)
+		w.writeEscaped(v.Lang)
+		w.writeString(`">`)
+		w.writeEscaped(string(v.Value))
+		w.writeString("</plainLiteral>\n")
+	case quad.TypedString:
+		w.writeString(`<typedLiteral datatype="`)
+		w.writeEscaped(string(v.Type))
+		w.writeString(`">`)
+		w.writeEscaped(string(v.Value))
+		w.writeString("</typedLiteral>\n")
+	default:
+		w.writeString("<plainLiteral>")
+		w.writeEscaped(v.String())
+		w.writeString("</plainLiteral>\n")
+	}
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	}
+	if !w.written {
+		w.writeString(header)
+		w.written = true
+	}
+	if !w.inGraph || q.Label != w.label {
+		if w.inGraph {
+			w.writeString("\t</graph>\n")
+		}
+		w.writeString("\t<graph>\n")
+		if q.Label != nil {
+			w.writeTerm("\t\t", q.Label)
+		}
+		w.inGraph, w.label = true, q.Label
+	}
+	w.writeString("\t\t<triple>\n")
+	w.writeTerm("\t\t\t", q.Subject)
+	w.writeTerm("\t\t\t", q.Predicate)
+	w.writeTerm("\t\t\t", q.Object)
+	w.writeString("\t\t</triple>\n")
+	return w.err
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+// Close finishes the document and flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if !w.written {
+		w.writeString(header)
+	}
+	if w.inGraph {
+		w.writeString("\t</graph>\n")
+	}
+	w.writeString(footer)
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	if w.err != nil {
+		return w.err
+	}
+	w.err = fmt.Errorf("closed")
+	return nil
+}
+
+const header = `<?xml version="1.0" encoding="UTF-8"?>
+<TriX xmlns="` + NS + `">
+`
+const footer = "</TriX>\n"
@@ -0,0 +1,105 @@
+package trix_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/trix"
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+var testQuads = []quad.Quad{
+	{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String(`Alice & "Bob"`)},
+	{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("bob")},
+	{Subject: quad.BNode("bob"), Predicate: iri("title"), Object: quad.LangString{Value: "Dr", Lang: "en"}, Label: iri("g1")},
+	{Subject: quad.BNode("bob"), Predicate: iri("age"), Object: quad.Int(42), Label: iri("g1")},
+	{Subject: quad.BNode("bob"), Predicate: iri("born"), Object: quad.TypedString{Value: "1990-07", Type: "http://www.w3.org/2001/XMLSchema#gYearMonth"}, Label: quad.BNode("g2")},
+}
+
+const testData = `<?xml version="1.0" encoding="UTF-8"?>
+<TriX xmlns="http://www.w3.org/2004/03/trix/trix-1/">
+	<graph>
+		<triple>
+			<uri>http://example.org/alice</uri>
+			<uri>http://example.org/name</uri>
+			<plainLiteral>Alice &amp; &#34;Bob&#34;</plainLiteral>
+		</triple>
+		<triple>
+			<uri>http://example.org/alice</uri>
+			<uri>http://example.org/knows</uri>
+			<id>bob</id>
+		</triple>
+	</graph>
+	<graph>
+		<uri>http://example.org/g1</uri>
+		<triple>
+			<id>bob</id>
+			<uri>http://example.org/title</uri>
+			<plainLiteral xml:lang="en">Dr</plainLiteral>
+		</triple>
+		<triple>
+			<id>bob</id>
+			<uri>http://example.org/age</uri>
+			<typedLiteral datatype="http://www.w3.org/2001/XMLSchema#integer">42</typedLiteral>
+		</triple>
+	</graph>
+	<graph>
+		<id>g2</id>
+		<triple>
+			<id>bob</id>
+			<uri>http://example.org/born</uri>
+			<typedLiteral datatype="http://www.w3.org/2001/XMLSchema#gYearMonth">1990-07</typedLiteral>
+		</triple>
+	</graph>
+</TriX>
+`
+
+func TestWriter(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	w := trix.NewWriter(buf)
+	_, err := quad.Copy(w, quad.NewReader(testQuads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, testData, buf.String())
+}
+
+func TestReader(t *testing.T) {
+	r := trix.NewReader(strings.NewReader(testData))
+	defer r.Close()
+	quads, err := quad.ReadAll(r)
+	require.NoError(t, err)
+	require.Equal(t, testQuads, quads)
+}
+
+func TestReaderFormat(t *testing.T) {
+	f := quad.FormatByMime("application/trix")
+	require.NotNil(t, f)
+	require.Equal(t, "trix", f.Name)
+	require.Equal(t, f, quad.FormatByExt(".trix"))
+}
+
+func TestReaderErrors(t *testing.T) {
+	_, err := quad.ReadAll(trix.NewReader(strings.NewReader(`<TriX xmlns="http://www.w3.org/2004/03/trix/trix-1/">
+	<graph>
+		<triple><uri>a</uri><uri>b</uri></triple>
+	</graph>
+</TriX>`)))
+	require.EqualError(t, err, `line 3: expected 3 terms in a triple, got 2`)
+
+	_, err = quad.ReadAll(trix.NewReader(strings.NewReader(`<TriX xmlns="http://www.w3.org/2004/03/trix/trix-1/">
+	<graph>
+		<triple><uri>a</uri><uri>b</uri><blank>c</blank></triple>
+	</graph>
+</TriX>`)))
+	require.EqualError(t, err, `line 3: unexpected element: blank`)
+
+	_, err = quad.ReadAll(trix.NewReader(strings.NewReader(`<TriX xmlns="http://www.w3.org/2004/03/trix/trix-1/">
+	<graph>
+		<triple><uri>a</uri><uri>b</uri><uri>c</uri></triple>`)))
+	require.EqualError(t, err, `XML syntax error on line 3: unexpected EOF`)
+}
@@ -0,0 +1,374 @@
+package turtle
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokEOF      tokenKind = iota
+	tokIRI                // <iri>
+	tokPName              // prefix:local
+	tokBNode              // _:label
+	tokString             // "string" or 'string'
+	tokLangTag            // @lang, @prefix, @base
+	tokDatatype           // ^^
+	tokInteger            // 1
+	tokDecimal            // 1.0
+	tokDouble             // 1e0
+	tokWord               // a, true, false, PREFIX, BASE, GRAPH
+	tokPunct              // . ; , [ ] ( ) { }
+)
+
+type token struct {
+	kind tokenKind
+	val  string
+	line int
+}
+
+func (t token) String() string {
+	switch t.kind {
+	case tokEOF:
+		return "EOF"
+	case tokIRI:
+		return "<" + t.val + ">"
+	case tokBNode:
+		return "_:" + t.val
+	case tokString:
+		return strconv.Quote(t.val)
+	case tokLangTag:
+		return "@" + t.val
+	case tokDatatype:
+		return "^^"
+	}
+	return t.val
+}
+
+func (t token) is(punct string) bool {
+	return t.kind == tokPunct && t.val == punct
+}
+
+// lexer splits Turtle document into tokens.
+type lexer struct {
+	r    *bufio.Reader
+	la   []rune // lookahead buffer
+	line int
+	err  error
+}
+
+func newLexer(r io.Reader) *lexer {
+	return &lexer{r: bufio.NewReader(r), line: 1}
+}
+
+// peek returns i-th rune after the current position, or -1 on EOF.
+func (l *lexer) peek(i int) rune {
+	for len(l.la) <= i {
+		c, _, err := l.r.ReadRune()
+		if err != nil {
+			if err != io.EOF && l.err == nil {
+				l.err = err
+			}
+			return -1
+		}
+		l.la = append(l.la, c)
+	}
+	return l.la[i]
+}
+
+// next consumes and returns current rune, or -1 on EOF.
+func (l *lexer) next() rune {
+	c := l.peek(0)
+	if c < 0 {
+		return c
+	}
+	l.la = l.la[1:]
+	if c == '\n' {
+		l.line++
+	}
+	return c
+}
+
+func (l *lexer) errorf(format string, args ...interface{}) error {
+	return fmt.Errorf("line %d: "+format, append([]interface{}{l.line}, args...)...)
+}
+
+func (l *lexer) skipSpace() {
+	for {
+		switch c := l.peek(0); c {
+		case ' ', '\t', '\r', '\n':
+			l.next()
+		case '#':
+			for c = l.next(); c >= 0 && c != '\n'; c = l.next() {
+			}
+		default:
+			return
+		}
+	}
+}
+
+func isDigit(c rune) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isNameChar(c rune) bool {
+	switch c {
+	case '_', '-', ':', '%', 0xB7:
+		return true
+	}
+	return c > 0 && (unicode.IsLetter(c) || unicode.IsDigit(c) || unicode.IsMark(c))
+}
+
+// Token returns the next token from the stream.
+func (l *lexer) Token() (token, error) {
+	l.skipSpace()
+	t := token{line: l.line}
+	c := l.peek(0)
+	var err error
+	switch {
+	case c < 0:
+		if l.err != nil {
+			return t, l.err
+		}
+		t.kind = tokEOF
+	case c == '<':
+		t.kind = tokIRI
+		t.val, err = l.iri()
+	case c == '"' || c == '\'':
+		t.kind = tokString
+		t.val, err = l.str()
+	case c == '@':
+		l.next()
+		t.kind = tokLangTag
+		t.val, err = l.langTag()
+	case c == '^':
+		l.next()
+		if l.next() != '^' {
+			return t, l.errorf("expected '^^'")
+		}
+		t.kind = tokDatatype
+	case c == '_' && l.peek(1) == ':':
+		l.next()
+		l.next()
+		t.kind = tokBNode
+		t.val = l.name()
+		if t.val == "" {
+			return t, l.errorf("empty blank node label")
+		}
+	case isDigit(c) || c == '+' || c == '-' || (c == '.' && isDigit(l.peek(1))):
+		t.kind, t.val, err = l.number()
+	case strings.ContainsRune(".;,[](){}", c):
+		l.next()
+		t.kind = tokPunct
+		t.val = string(c)
+	default:
+		t.val = l.name()
+		if t.val == "" {
+			return t, l.errorf("unexpected character %q", c)
+		}
+		if strings.ContainsRune(t.val, ':') {
+			t.kind = tokPName
+		} else {
+			t.kind = tokWord
+		}
+	}
+	return t, err
+}
+
+func (l *lexer) escapedRune(n int) (rune, error) {
+	var buf [8]rune
+	for i := 0; i < n; i++ {
+		buf[i] = l.next()
+	}
+	v, err := strconv.ParseUint(string(buf[:n]), 16, 32)
+	if err != nil {
+		return 0, l.errorf("invalid escape sequence: %q", string(buf[:n]))
+	}
+	return rune(v), nil
+}
+
+func (l *lexer) iri() (string, error) {
+	l.next() // <
+	var sb strings.Builder
+	for {
+		c := l.next()
+		switch c {
+		case -1:
+			return "", l.errorf("unterminated IRI")
+		case '>':
+			return sb.String(), nil
+		case '\\':
+			var (
+				r   rune
+				err error
+			)
+			switch l.next() {
+			case 'u':
+				r, err = l.escapedRune(4)
+			case 'U':
+				r, err = l.escapedRune(8)
+			default:
+				err = l.errorf("invalid escape sequence in IRI")
+			}
+			if err != nil {
+				return "", err
+			}
+			sb.WriteRune(r)
+		case ' ', '\t', '\r', '\n', '<', '"', '{', '}', '|', '^', '`':
+			return "", l.errorf("invalid character in IRI: %q", c)
+		default:
+			sb.WriteRune(c)
+		}
+	}
+}
+
+func (l *lexer) str() (string, error) {
+	q := l.next()
+	long := false
+	if l.peek(0) == q {
+		if l.peek(1) != q {
+			l.next()
+			return "", nil
+		}
+		l.next()
+		l.next()
+		long = true
+	}
+	var sb strings.Builder
+	for {
+		c := l.next()
+		switch {
+		case c < 0:
+			return "", l.errorf("unterminated string")
+		case c == '\\':
+			var r rune
+			switch e := l.next(); e {
+			case 't':
+				r = '\t'
+			case 'b':
+				r = '\b'
+			case 'n':
+				r = '\n'
+			case 'r':
+				r = '\r'
+			case 'f':
+				r = '\f'
+			case '"', '\'', '\\':
+				r = e
+			case 'u', 'U':
+				n := 4
+				if e == 'U' {
+					n = 8
+				}
+				var err error
+				if r, err = l.escapedRune(n); err != nil {
+					return "", err
+				}
+			default:
+				return "", l.errorf("invalid escape sequence: \\%c", e)
+			}
+			sb.WriteRune(r)
+		case c == q && !long:
+			return sb.String(), nil
+		case c == q && l.peek(0) == q && l.peek(1) == q && l.peek(2) != q:
+			l.next()
+			l.next()
+			return sb.String(), nil
+		case (c == '\n' || c == '\r') && !long:
+			return "", l.errorf("line break in a short string")
+		default:
+			sb.WriteRune(c)
+		}
+	}
+}
+
+func (l *lexer) langTag() (string, error) {
+	var sb strings.Builder
+	for c := l.peek(0); (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') ||
+		(sb.Len() > 0 && (c == '-' || isDigit(c))); c = l.peek(0) {
+		sb.WriteRune(l.next())
+	}
+	if sb.Len() == 0 {
+		return "", l.errorf("empty language tag")
+	}
+	return sb.String(), nil
+}
+
+func (l *lexer) digits(sb *strings.Builder) int {
+	n := 0
+	for isDigit(l.peek(0)) {
+		sb.WriteRune(l.next())
+		n++
+	}
+	return n
+}
+
+func (l *lexer) isExponent(i int) bool {
+	if c := l.peek(i); c != 'e' && c != 'E' {
+		return false
+	}
+	if c := l.peek(i + 1); c == '+' || c == '-' {
+		i++
+	}
+	return isDigit(l.peek(i + 1))
+}
+
+func (l *lexer) number() (tokenKind, string, error) {
+	var sb strings.Builder
+	if c := l.peek(0); c == '+' || c == '-' {
+		sb.WriteRune(l.next())
+	}
+	kind := tokInteger
+	n := l.digits(&sb)
+	if l.peek(0) == '.' && (isDigit(l.peek(1)) || (n > 0 && l.isExponent(1))) {
+		sb.WriteRune(l.next())
+		n += l.digits(&sb)
+		kind = tokDecimal
+	}
+	if n == 0 {
+		return kind, "", l.errorf("invalid number: %q", sb.String())
+	}
+	if l.isExponent(0) {
+		sb.WriteRune(l.next())
+		if c := l.peek(0); c == '+' || c == '-' {
+			sb.WriteRune(l.next())
+		}
+		l.digits(&sb)
+		kind = tokDouble
+	}
+	return kind, sb.String(), nil
+}
+
+// name reads a prefixed name or a keyword. Escape sequences in local names are decoded.
+func (l *lexer) name() string {
+	var sb strings.Builder
+	for {
+		c := l.peek(0)
+		switch {
+		case c == '\\' && sb.Len() > 0:
+			l.next()
+			sb.WriteRune(l.next())
+		case c == '.' && sb.Len() > 0:
+			// names cannot end with a dot
+			i := 1
+			for l.peek(i) == '.' {
+				i++
+			}
+			if !isNameChar(l.peek(i)) {
+				return sb.String()
+			}
+			for ; i > 0; i-- {
+				sb.WriteRune(l.next())
+			}
+		case isNameChar(c):
+			sb.WriteRune(l.next())
+		default:
+			return sb.String()
+		}
+	}
+}
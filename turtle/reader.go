@@ -0,0 +1,522 @@
+package turtle
+
+import (
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/internal/bnode"
+)
+
+var _ quad.ReadCloser = (*Reader)(nil)
+
+// Reader implements Turtle document parsing according to the RDF 1.1 Turtle specification.
+// It can also parse TriG documents, if created with NewTriGReader.
+//
+// Relative IRIs are resolved against the last @base directive, or are left unchanged if no base was set.
+type Reader struct {
+	lex *lexer
+	tok token
+	has bool // tok contains a lookahead token
+
+	base     *url.URL
+	prefixes map[string]string
+	seq      bnode.Generator
+
+	trig    bool
+	inGraph bool
+	label   quad.Value
+
+	buf []quad.Quad
+	cur int
+	err error
+}
+
+// NewReader returns a Turtle decoder that takes its input from the provided io.Reader.
+func NewReader(r io.Reader) *Reader {
+	return &Reader{
+		lex:      newLexer(r),
+		prefixes: make(map[string]string),
+	}
+}
+
+// NewTriGReader returns a TriG decoder that takes its input from the provided io.Reader.
+//
+// Quads from named graph blocks will have the graph name set as a Label.
+func NewTriGReader(r io.Reader) *Reader {
+	dec := NewReader(r)
+	dec.trig = true
+	return dec
+}
+
+// ReadQuad returns the next valid quad, or an error.
+func (r *Reader) ReadQuad() (quad.Quad, error) {
+	for r.cur >= len(r.buf) {
+		if r.err != nil {
+			return quad.Quad{}, r.err
+		}
+		r.buf, r.cur = r.buf[:0], 0
+		r.err = r.statement()
+	}
+	q := r.buf[r.cur]
+	r.cur++
+	return q, nil
+}
+
+// Close implements quad.ReadCloser.
+func (r *Reader) Close() error { return nil }
+
+func (r *Reader) emit(s, p, o quad.Value) {
+	r.buf = append(r.buf, quad.Quad{Subject: s, Predicate: p, Object: o, Label: r.label})
+}
+
+func (r *Reader) next() (token, error) {
+	if r.has {
+		r.has = false
+		return r.tok, nil
+	}
+	return r.lex.Token()
+}
+
+func (r *Reader) peek() (token, error) {
+	if !r.has {
+		t, err := r.lex.Token()
+		if err != nil {
+			return t, err
+		}
+		r.tok, r.has = t, true
+	}
+	return r.tok, nil
+}
+
+// accept consumes the next token if it's a given punctuation mark.
+func (r *Reader) accept(punct string) (bool, error) {
+	t, err := r.peek()
+	if err != nil {
+		return false, err
+	} else if !t.is(punct) {
+		return false, nil
+	}
+	r.has = false
+	return true, nil
+}
+
+func (r *Reader) expect(punct string) error {
+	t, err := r.next()
+	if err != nil {
+		return err
+	} else if !t.is(punct) {
+		return unexpected(t, "'"+punct+"'")
+	}
+	return nil
+}
+
+func unexpected(t token, exp string) error {
+	if t.kind == tokEOF {
+		return fmt.Errorf("line %d: expected %s: %w", t.line, exp, io.ErrUnexpectedEOF)
+	}
+	return fmt.Errorf("line %d: expected %s, got %v", t.line, exp, t)
+}
+
+// statement parses a single directive or a set of triples.
+func (r *Reader) statement() error {
+	if r.inGraph {
+		return r.graphStatement()
+	}
+	t, err := r.next()
+	if err != nil {
+		return err
+	}
+	switch {
+	case t.kind == tokEOF:
+		return io.EOF
+	case t.kind == tokLangTag && t.val == "prefix":
+		if err = r.prefixID(); err != nil {
+			return err
+		}
+		return r.expect(".")
+	case t.kind == tokLangTag && t.val == "base":
+		if err = r.baseID(); err != nil {
+			return err
+		}
+		return r.expect(".")
+	case t.kind == tokWord && strings.EqualFold(t.val, "PREFIX"):
+		return r.prefixID()
+	case t.kind == tokWord && strings.EqualFold(t.val, "BASE"):
+		return r.baseID()
+	}
+	if r.trig {
+		if ok, err := r.graph(t); err != nil || ok {
+			return err
+		}
+	}
+	if err = r.triples(t); err != nil {
+		return err
+	}
+	return r.expect(".")
+}
+
+// graph checks if a given token starts a TriG graph block and consumes the block header.
+// It returns false if the token starts a regular set of triples instead.
+func (r *Reader) graph(t token) (bool, error) {
+	var label quad.Value
+	switch {
+	case t.is("{"):
+	case t.kind == tokWord && strings.EqualFold(t.val, "GRAPH"):
+		n, err := r.next()
+		if err != nil {
+			return false, err
+		}
+		if n.is("[") {
+			if err = r.expect("]"); err != nil {
+				return false, err
+			}
+			label = r.seq.Next()
+		} else if label, err = r.graphName(n); err != nil {
+			return false, err
+		}
+		if err = r.expect("{"); err != nil {
+			return false, err
+		}
+	case t.kind == tokIRI || t.kind == tokPName || t.kind == tokBNode:
+		if n, err := r.peek(); err != nil || !n.is("{") {
+			return false, err
+		}
+		r.has = false
+		var err error
+		if label, err = r.graphName(t); err != nil {
+			return false, err
+		}
+	case t.is("["):
+		if n, err := r.peek(); err != nil || !n.is("]") {
+			return false, err
+		}
+		r.has = false
+		b := r.seq.Next()
+		if ok, err := r.accept("{"); err != nil {
+			return false, err
+		} else if !ok {
+			// it was an empty blank node subject
+			if err = r.predicateObjectList(b); err != nil {
+				return false, err
+			}
+			return true, r.expect(".")
+		}
+		label = b
+	default:
+		return false, nil
+	}
+	r.inGraph, r.label = true, label
+	return true, nil
+}
+
+func (r *Reader) graphName(t token) (quad.Value, error) {
+	switch t.kind {
+	case tokIRI, tokPName:
+		return r.iri(t)
+	case tokBNode:
+		return quad.BNode(t.val), nil
+	}
+	return nil, unexpected(t, "graph name")
+}
+
+// graphStatement parses a set of triples inside of TriG graph block, or the end of the block.
+func (r *Reader) graphStatement() error {
+	if ok, err := r.accept("}"); err != nil {
+		return err
+	} else if ok {
+		r.inGraph, r.label = false, nil
+		return nil
+	}
+	t, err := r.next()
+	if err != nil {
+		return err
+	}
+	if err = r.triples(t); err != nil {
+		return err
+	}
+	// the last dot in the block is optional
+	if ok, err := r.accept("."); err != nil || ok {
+		return err
+	}
+	if t, err = r.peek(); err != nil {
+		return err
+	} else if !t.is("}") {
+		return unexpected(t, "'.' or '}'")
+	}
+	return nil
+}
+
+func (r *Reader) prefixID() error {
+	t, err := r.next()
+	if err != nil {
+		return err
+	} else if t.kind != tokPName || !strings.HasSuffix(t.val, ":") {
+		return unexpected(t, "prefix name")
+	}
+	name := t.val
+	if t, err = r.next(); err != nil {
+		return err
+	} else if t.kind != tokIRI {
+		return unexpected(t, "IRI")
+	}
+	r.prefixes[strings.TrimSuffix(name, ":")] = string(r.resolve(t.val))
+	return nil
+}
+
+func (r *Reader) baseID() error {
+	t, err := r.next()
+	if err != nil {
+		return err
+	} else if t.kind != tokIRI {
+		return unexpected(t, "IRI")
+	}
+	base, err := url.Parse(string(r.resolve(t.val)))
+	if err != nil {
+		return fmt.Errorf("line %d: invalid base IRI: %v", t.line, err)
+	}
+	r.base = base
+	return nil
+}
+
+// resolve resolves IRI relative to the current base.
+func (r *Reader) resolve(iri string) quad.IRI {
+	if r.base == nil {
+		return quad.IRI(iri)
+	}
+	u, err := url.Parse(iri)
+	if err != nil || u.IsAbs() {
+		return quad.IRI(iri)
+	}
+	return quad.IRI(r.base.ResolveReference(u).String())
+}
+
+// expand converts prefixed name to a full IRI.
+func (r *Reader) expand(t token) (quad.IRI, error) {
+	i := strings.IndexByte(t.val, ':')
+	ns, ok := r.prefixes[t.val[:i]]
+	if !ok {
+		return "", fmt.Errorf("line %d: undefined prefix %q", t.line, t.val[:i+1])
+	}
+	return quad.IRI(ns + t.val[i+1:]), nil
+}
+
+func (r *Reader) iri(t token) (quad.IRI, error) {
+	switch t.kind {
+	case tokIRI:
+		return r.resolve(t.val), nil
+	case tokPName:
+		return r.expand(t)
+	}
+	return "", unexpected(t, "IRI")
+}
+
+// triples parses a subject (given its first token) with a predicate-object list.
+func (r *Reader) triples(t token) error {
+	var s quad.Value
+	switch {
+	case t.is("["):
+		s = r.seq.Next()
+		if err := r.blankNodePropertyList(s); err != nil {
+			return err
+		}
+		// predicate-object list is optional for blank node property lists
+		if t, err := r.peek(); err != nil {
+			return err
+		} else if t.is(".") || t.is("}") {
+			return nil
+		}
+	case t.is("("):
+		var err error
+		if s, err = r.collection(); err != nil {
+			return err
+		}
+	case t.kind == tokBNode:
+		s = quad.BNode(t.val)
+	case t.kind == tokIRI || t.kind == tokPName:
+		var err error
+		if s, err = r.iri(t); err != nil {
+			return err
+		}
+	default:
+		return unexpected(t, "subject")
+	}
+	return r.predicateObjectList(s)
+}
+
+func (r *Reader) predicateObjectList(s quad.Value) error {
+	for {
+		p, err := r.verb()
+		if err != nil {
+			return err
+		}
+		if err = r.objectList(s, p); err != nil {
+			return err
+		}
+		if ok, err := r.accept(";"); err != nil || !ok {
+			return err
+		}
+		for {
+			if ok, err := r.accept(";"); err != nil {
+				return err
+			} else if !ok {
+				break
+			}
+		}
+		if t, err := r.peek(); err != nil {
+			return err
+		} else if t.is(".") || t.is("]") || t.is("}") {
+			return nil
+		}
+	}
+}
+
+func (r *Reader) verb() (quad.Value, error) {
+	t, err := r.next()
+	if err != nil {
+		return nil, err
+	} else if t.kind == tokWord && t.val == "a" {
+		return rdfType, nil
+	} else if t.kind != tokIRI && t.kind != tokPName {
+		return nil, unexpected(t, "predicate")
+	}
+	return r.iri(t)
+}
+
+func (r *Reader) objectList(s, p quad.Value) error {
+	for {
+		if err := r.object(s, p); err != nil {
+			return err
+		}
+		if ok, err := r.accept(","); err != nil || !ok {
+			return err
+		}
+	}
+}
+
+// object parses a single object and emits corresponding triples.
+func (r *Reader) object(s, p quad.Value) error {
+	t, err := r.next()
+	if err != nil {
+		return err
+	}
+	switch {
+	case t.is("["):
+		o := r.seq.Next()
+		r.emit(s, p, o)
+		return r.blankNodePropertyList(o)
+	case t.is("("):
+		if ok, err := r.accept(")"); err != nil {
+			return err
+		} else if ok {
+			r.emit(s, p, rdfNil)
+			return nil
+		}
+		o := r.seq.Next()
+		r.emit(s, p, o)
+		return r.collectionItems(o)
+	}
+	o, err := r.term(t)
+	if err != nil {
+		return err
+	}
+	r.emit(s, p, o)
+	return nil
+}
+
+// blankNodePropertyList parses the contents of [ ... ] block, including the closing bracket.
+func (r *Reader) blankNodePropertyList(s quad.Value) error {
+	if ok, err := r.accept("]"); err != nil || ok {
+		return err
+	}
+	if err := r.predicateObjectList(s); err != nil {
+		return err
+	}
+	return r.expect("]")
+}
+
+// collection parses the contents of ( ... ) block and returns the list head.
+func (r *Reader) collection() (quad.Value, error) {
+	if ok, err := r.accept(")"); err != nil {
+		return nil, err
+	} else if ok {
+		return rdfNil, nil
+	}
+	head := r.seq.Next()
+	return head, r.collectionItems(head)
+}
+
+func (r *Reader) collectionItems(node quad.Value) error {
+	for {
+		if err := r.object(node, rdfFirst); err != nil {
+			return err
+		}
+		if ok, err := r.accept(")"); err != nil {
+			return err
+		} else if ok {
+			r.emit(node, rdfRest, rdfNil)
+			return nil
+		}
+		next := r.seq.Next()
+		r.emit(node, rdfRest, next)
+		node = next
+	}
+}
+
+// term converts a token to IRI, blank node or a literal value.
+func (r *Reader) term(t token) (quad.Value, error) {
+	switch t.kind {
+	case tokIRI, tokPName:
+		return r.iri(t)
+	case tokBNode:
+		return quad.BNode(t.val), nil
+	case tokString:
+		return r.literal(t)
+	case tokInteger:
+		return typed(t.val, xsdInteger), nil
+	case tokDecimal:
+		return typed(t.val, xsdDecimal), nil
+	case tokDouble:
+		return typed(t.val, xsdDouble), nil
+	case tokWord:
+		if t.val == "true" || t.val == "false" {
+			return typed(t.val, xsdBoolean), nil
+		}
+	}
+	return nil, unexpected(t, "object")
+}
+
+func (r *Reader) literal(t token) (quad.Value, error) {
+	n, err := r.peek()
+	if err != nil {
+		return nil, err
+	}
+	switch n.kind {
+	case tokLangTag:
+		r.has = false
+		return quad.LangString{Value: quad.String(t.val), Lang: n.val}, nil
+	case tokDatatype:
+		r.has = false
+		if n, err = r.next(); err != nil {
+			return nil, err
+		}
+		dt, err := r.iri(n)
+		if err != nil {
+			return nil, err
+		}
+		return typed(t.val, dt), nil
+	}
+	return quad.String(t.val), nil
+}
+
+func typed(s string, dt quad.IRI) quad.Value {
+	v := quad.TypedString{Value: quad.String(s), Type: dt}
+	if AutoConvertTypedString {
+		if nv, err := v.ParseValue(); err == nil {
+			return nv
+		}
+	}
+	return v
+}
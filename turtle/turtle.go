@@ -0,0 +1,130 @@
+// Package turtle implements parsing and serialization of the RDF 1.1 Turtle syntax
+// and its TriG extension for named graphs.
+//
+// See https://www.w3.org/TR/turtle/ and https://www.w3.org/TR/trig/ for the grammar definition.
+package turtle
+
+import (
+	"bytes"
+	"io"
+	"strings"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/voc/rdf"
+	"github.com/cayleygraph/quad/voc/xsd"
+)
+
+// AutoConvertTypedString allows to convert TypedString values to native
+// equivalents directly while parsing. It will call ToNative on all TypedString values.
+//
+// If conversion error occurs, it will preserve original TypedString value.
+var AutoConvertTypedString = true
+
+func init() {
+	quad.RegisterFormat(quad.Format{
+		Name:   "turtle",
+		Ext:    []string{".ttl"},
+		Mime:   []string{"text/turtle"},
+		Reader: func(r io.Reader) quad.ReadCloser { return NewReader(r) },
+		Writer: func(w io.Writer) quad.WriteCloser { return NewWriter(w, nil) },
+		Sniff: func(head []byte) bool {
+			directive, graph := sniff(head)
+			return directive && !graph
+		},
+	})
+	quad.RegisterFormat(quad.Format{
+		Name:   "trig",
+		Ext:    []string{".trig"},
+		Mime:   []string{"application/trig"},
+		Reader: func(r io.Reader) quad.ReadCloser { return NewTriGReader(r) },
+		Writer: func(w io.Writer) quad.WriteCloser { return NewTriGWriter(w, nil) },
+		Sniff: func(head []byte) bool {
+			_, graph := sniff(head)
+			return graph
+		},
+	})
+}
+
+// sniff checks if the document starts with a prefix or base directive, and if it contains a TriG graph block.
+//
+// Documents without directives are not recognised as Turtle, since they are usually valid N-Triples as well.
+// Graph blocks are only recognised in documents that start with a directive, a GRAPH keyword or a subject,
+// and only if they start within the head.
+func sniff(head []byte) (directive, graph bool) {
+	for n := 0; ; n++ {
+		var tok string
+		if tok, head = sniffToken(head); tok == "" {
+			return directive, false
+		}
+		switch {
+		case strings.EqualFold(tok, "GRAPH") || (tok == "{" && n != 0):
+			return directive, true
+		case n != 0:
+			continue
+		case tok == "@prefix" || tok == "@base" || strings.EqualFold(tok, "PREFIX") || strings.EqualFold(tok, "BASE"):
+			directive = true
+		case strings.HasPrefix(tok, "_:"):
+		case tok[0] != '<' || strings.ContainsAny(tok, " \t\r\n\"{}|^`\\"):
+			return false, false // not an IRI, for example an XML tag
+		}
+	}
+}
+
+// sniffToken returns the next token of the document, skipping whitespace and comments.
+// It returns an empty token at the end of the input, or if the token is not terminated.
+func sniffToken(b []byte) (string, []byte) {
+	for len(b) != 0 {
+		switch c := b[0]; c {
+		case ' ', '\t', '\r', '\n':
+			b = b[1:]
+			continue
+		case '#':
+			i := bytes.IndexByte(b, '\n')
+			if i < 0 {
+				return "", nil
+			}
+			b = b[i+1:]
+			continue
+		case '<':
+			i := bytes.IndexByte(b, '>')
+			if i < 0 {
+				return "", nil
+			}
+			return string(b[:i+1]), b[i+1:]
+		case '"', '\'':
+			q := b[:1]
+			if len(b) >= 3 && b[1] == c && b[2] == c {
+				q = b[:3]
+			}
+			for i := len(q); i < len(b); i++ {
+				if b[i] == '\\' {
+					i++
+				} else if bytes.HasPrefix(b[i:], q) {
+					i += len(q)
+					return string(b[:i]), b[i:]
+				}
+			}
+			return "", nil
+		case '{', '}', '(', ')', '[', ']', ',', ';':
+			return string(b[:1]), b[1:]
+		}
+		i := bytes.IndexAny(b, " \t\r\n<\"'{}()[],;#")
+		if i < 0 {
+			return "", nil
+		}
+		return string(b[:i]), b[i:]
+	}
+	return "", nil
+}
+
+const (
+	rdfType  = quad.IRI(rdf.NS + "type")
+	rdfFirst = quad.IRI(rdf.NS + "first")
+	rdfRest  = quad.IRI(rdf.NS + "rest")
+	rdfNil   = quad.IRI(rdf.NS + "nil")
+
+	xsdInteger = quad.IRI(xsd.NS + "integer")
+	xsdDecimal = quad.IRI(xsd.NS + "decimal")
+	xsdDouble  = quad.IRI(xsd.NS + "double")
+	xsdBoolean = quad.IRI(xsd.NS + "boolean")
+)
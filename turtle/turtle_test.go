@@ -0,0 +1,385 @@
+package turtle_test
+
+import (
+	"bytes"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/turtle"
+	"github.com/cayleygraph/quad/voc"
+	"github.com/cayleygraph/quad/voc/rdf"
+	"github.com/cayleygraph/quad/voc/rdfs"
+)
+
+const (
+	rdfType  = quad.IRI("http://www.w3.org/1999/02/22-rdf-syntax-ns#type")
+	rdfFirst = quad.IRI("http://www.w3.org/1999/02/22-rdf-syntax-ns#first")
+	rdfRest  = quad.IRI("http://www.w3.org/1999/02/22-rdf-syntax-ns#rest")
+	rdfNil   = quad.IRI("http://www.w3.org/1999/02/22-rdf-syntax-ns#nil")
+)
+
+func iri(s string) quad.IRI { return quad.IRI("http://example.org/" + s) }
+
+var readTests = []struct {
+	name   string
+	data   string
+	expect []quad.Quad
+}{
+	{
+		name: "prefixes and lists",
+		data: `@prefix ex: <http://example.org/> .
+PREFIX : <http://example.org/>
+
+ex:alice a ex:Person ;
+	ex:knows ex:bob, :carol ; ;
+	ex:name "Alice" .
+`,
+		expect: []quad.Quad{
+			{Subject: iri("alice"), Predicate: rdfType, Object: iri("Person")},
+			{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob")},
+			{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("carol")},
+			{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String("Alice")},
+		},
+	},
+	{
+		name: "base resolution",
+		data: `@base <http://example.org/a/> .
+<b> <#p> <../c> .
+BASE <http://example.com/>
+<d> <e> <f> .
+`,
+		expect: []quad.Quad{
+			{Subject: iri("a/b"), Predicate: iri("a/#p"), Object: iri("c")},
+			{Subject: quad.IRI("http://example.com/d"), Predicate: quad.IRI("http://example.com/e"), Object: quad.IRI("http://example.com/f")},
+		},
+	},
+	{
+		name: "literals",
+		data: `@prefix ex: <http://example.org/> .
+@prefix xsd: <http://www.w3.org/2001/XMLSchema#> .
+ex:s ex:int 42 ;
+	ex:neg -5 ;
+	ex:dec 1.5 ;
+	ex:dbl 1.5e3 ;
+	ex:bool true ;
+	ex:lang "chat"@fr ;
+	ex:typed "7"^^xsd:integer ;
+	ex:date "1990-07-04"^^<http://www.w3.org/2001/XMLSchema#date> ;
+	ex:esc 'it\'s a "A"\n' ;
+	ex:long """multi
+"line" """ .
+`,
+		expect: []quad.Quad{
+			{Subject: iri("s"), Predicate: iri("int"), Object: quad.Int(42)},
+			{Subject: iri("s"), Predicate: iri("neg"), Object: quad.Int(-5)},
+			{Subject: iri("s"), Predicate: iri("dec"), Object: quad.TypedString{Value: "1.5", Type: "http://www.w3.org/2001/XMLSchema#decimal"}},
+			{Subject: iri("s"), Predicate: iri("dbl"), Object: quad.Float(1500)},
+			{Subject: iri("s"), Predicate: iri("bool"), Object: quad.Bool(true)},
+			{Subject: iri("s"), Predicate: iri("lang"), Object: quad.LangString{Value: "chat", Lang: "fr"}},
+			{Subject: iri("s"), Predicate: iri("typed"), Object: quad.Int(7)},
+			{Subject: iri("s"), Predicate: iri("date"), Object: quad.TypedString{Value: "1990-07-04", Type: "http://www.w3.org/2001/XMLSchema#date"}},
+			{Subject: iri("s"), Predicate: iri("esc"), Object: quad.String("it's a \"A\"\n")},
+			{Subject: iri("s"), Predicate: iri("long"), Object: quad.String("multi\n\"line\" ")},
+		},
+	},
+	{
+		name: "blank nodes",
+		data: `@prefix ex: <http://example.org/> .
+_:a ex:p [ ex:q "x" ; ex:r [] ] .
+[ ex:name "anon" ] .
+[ ex:name "subj" ] ex:p ex:o .
+`,
+		expect: []quad.Quad{
+			{Subject: quad.BNode("a"), Predicate: iri("p"), Object: quad.BNode("n1")},
+			{Subject: quad.BNode("n1"), Predicate: iri("q"), Object: quad.String("x")},
+			{Subject: quad.BNode("n1"), Predicate: iri("r"), Object: quad.BNode("n2")},
+			{Subject: quad.BNode("n3"), Predicate: iri("name"), Object: quad.String("anon")},
+			{Subject: quad.BNode("n4"), Predicate: iri("name"), Object: quad.String("subj")},
+			{Subject: quad.BNode("n4"), Predicate: iri("p"), Object: iri("o")},
+		},
+	},
+	{
+		name: "collections",
+		data: `@prefix ex: <http://example.org/> .
+ex:s ex:list ( 1 ex:a ( ) ) .
+( "x" ) ex:p ex:o .
+`,
+		expect: []quad.Quad{
+			{Subject: iri("s"), Predicate: iri("list"), Object: quad.BNode("n1")},
+			{Subject: quad.BNode("n1"), Predicate: rdfFirst, Object: quad.Int(1)},
+			{Subject: quad.BNode("n1"), Predicate: rdfRest, Object: quad.BNode("n2")},
+			{Subject: quad.BNode("n2"), Predicate: rdfFirst, Object: iri("a")},
+			{Subject: quad.BNode("n2"), Predicate: rdfRest, Object: quad.BNode("n3")},
+			{Subject: quad.BNode("n3"), Predicate: rdfFirst, Object: rdfNil},
+			{Subject: quad.BNode("n3"), Predicate: rdfRest, Object: rdfNil},
+			{Subject: quad.BNode("n4"), Predicate: rdfFirst, Object: quad.String("x")},
+			{Subject: quad.BNode("n4"), Predicate: rdfRest, Object: rdfNil},
+			{Subject: quad.BNode("n4"), Predicate: iri("p"), Object: iri("o")},
+		},
+	},
+	{
+		name: "local names",
+		data: `@prefix ex: <http://example.org/> .
+ex:a.b ex:c\-d ex:1e. # comment
+`,
+		expect: []quad.Quad{
+			{Subject: iri("a.b"), Predicate: iri("c-d"), Object: iri("1e")},
+		},
+	},
+}
+
+// generated matches random prefixes of generated blank nodes.
+var generated = regexp.MustCompile(`^g[0-9a-f]{8}_`)
+
+// stripGenerated removes random prefixes of generated blank nodes, so quads can be compared.
+func stripGenerated(quads []quad.Quad) []quad.Quad {
+	strip := func(v quad.Value) quad.Value {
+		if b, ok := v.(quad.BNode); ok {
+			return quad.BNode(generated.ReplaceAllString(string(b), ""))
+		}
+		return v
+	}
+	for i, q := range quads {
+		quads[i] = quad.Quad{Subject: strip(q.Subject), Predicate: q.Predicate, Object: strip(q.Object), Label: strip(q.Label)}
+	}
+	return quads
+}
+
+func TestReader(t *testing.T) {
+	for _, c := range readTests {
+		t.Run(c.name, func(t *testing.T) {
+			r := turtle.NewReader(strings.NewReader(c.data))
+			defer r.Close()
+			quads, err := quad.ReadAll(r)
+			require.NoError(t, err)
+			require.Equal(t, c.expect, stripGenerated(quads))
+		})
+	}
+}
+
+var readErrorTests = []struct {
+	name string
+	data string
+	err  string
+}{
+	{name: "undefined prefix", data: `ex:a ex:b ex:c .`, err: `line 1: undefined prefix "ex:"`},
+	{name: "missing dot", data: "<a> <b> <c>\n<d> <e> <f> .", err: `line 2: expected '.', got <d>`},
+	{name: "literal subject", data: `"a" <b> <c> .`, err: `line 1: expected subject, got "a"`},
+	{name: "unterminated string", data: `<a> <b> "c .`, err: `line 1: unterminated string`},
+	{name: "unexpected eof", data: `<a> <b> `, err: `line 1: expected object: unexpected EOF`},
+}
+
+func TestReaderBlankNodeLabels(t *testing.T) {
+	const data = `@prefix ex: <http://example.org/> .
+_:n1 ex:p [ ex:q 1 ] .
+_:n2 ex:p ( 2 ) .
+`
+	quads, err := quad.ReadAll(turtle.NewReader(strings.NewReader(data)))
+	require.NoError(t, err)
+	require.Len(t, quads, 5)
+	require.Equal(t, quad.BNode("n1"), quads[0].Subject)
+	require.NotEqual(t, quads[0].Subject, quads[0].Object)
+	require.Equal(t, quads[0].Object, quads[1].Subject)
+	require.Equal(t, quad.BNode("n2"), quads[2].Subject)
+	require.NotEqual(t, quads[2].Subject, quads[2].Object)
+	require.NotEqual(t, quads[0].Object, quads[2].Object)
+
+	// labels are unique for each reader
+	again, err := quad.ReadAll(turtle.NewReader(strings.NewReader(data)))
+	require.NoError(t, err)
+	require.NotEqual(t, quads[0].Object, again[0].Object)
+}
+
+func TestReaderErrors(t *testing.T) {
+	for _, c := range readErrorTests {
+		t.Run(c.name, func(t *testing.T) {
+			r := turtle.NewReader(strings.NewReader(c.data))
+			_, err := quad.ReadAll(r)
+			require.EqualError(t, err, c.err)
+		})
+	}
+}
+
+func TestReaderFormat(t *testing.T) {
+	f := quad.FormatByExt(".ttl")
+	require.NotNil(t, f)
+	require.Equal(t, f, quad.FormatByMime("text/turtle"))
+	r := f.Reader(strings.NewReader(`<a> <b> <c> .`))
+	q, err := r.ReadQuad()
+	require.NoError(t, err)
+	require.Equal(t, quad.MakeIRI("a", "b", "c", ""), q)
+	_, err = r.ReadQuad()
+	require.Equal(t, io.EOF, err)
+}
+
+var writeTests = []struct {
+	name  string
+	quads []quad.Quad
+	data  string
+}{
+	{
+		name: "grouping",
+		quads: []quad.Quad{
+			{Subject: iri("alice"), Predicate: rdfType, Object: iri("Person")},
+			{Subject: iri("alice"), Predicate: iri("knows"), Object: iri("bob")},
+			{Subject: iri("alice"), Predicate: iri("knows"), Object: quad.BNode("carol")},
+			{Subject: iri("alice"), Predicate: iri("name"), Object: quad.String("Alice \"A\"")},
+			{Subject: quad.BNode("carol"), Predicate: iri("name"), Object: quad.LangString{Value: "Carol", Lang: "en"}},
+			{Subject: quad.IRI("http://other.org/x y"), Predicate: iri("p/q"), Object: iri("a.")},
+		},
+		data: `@prefix ex: <http://example.org/> .
+@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+
+ex:alice a ex:Person ;
+	ex:knows ex:bob, _:carol ;
+	ex:name "Alice \"A\"" .
+
+_:carol ex:name "Carol"@en .
+
+<http://other.org/x\u0020y> <http://example.org/p/q> <http://example.org/a.> .
+`,
+	},
+	{
+		name: "native values",
+		quads: []quad.Quad{
+			{Subject: iri("s"), Predicate: iri("int"), Object: quad.Int(-42)},
+			{Subject: iri("s"), Predicate: iri("float"), Object: quad.Float(1.5)},
+			{Subject: iri("s"), Predicate: iri("bool"), Object: quad.Bool(false)},
+			{Subject: iri("s"), Predicate: iri("time"), Object: quad.Time(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))},
+			{Subject: iri("s"), Predicate: iri("date"), Object: quad.TypedString{Value: "1990-07-04", Type: "http://www.w3.org/2001/XMLSchema#date"}},
+			{Subject: iri("s"), Predicate: iri("rdf"), Object: quad.TypedString{Value: "v", Type: "http://www.w3.org/1999/02/22-rdf-syntax-ns#HTML"}},
+		},
+		data: `@prefix ex: <http://example.org/> .
+@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+
+ex:s ex:int -42 ;
+	ex:float 1.5E+00 ;
+	ex:bool false ;
+	ex:time "2020-01-02T03:04:05Z"^^<http://www.w3.org/2001/XMLSchema#dateTime> ;
+	ex:date "1990-07-04"^^<http://www.w3.org/2001/XMLSchema#date> ;
+	ex:rdf "v"^^rdf:HTML .
+`,
+	},
+}
+
+func newNamespaces() *voc.Namespaces {
+	var ns voc.Namespaces
+	ns.Register(voc.Namespace{Prefix: "ex:", Full: "http://example.org/"})
+	ns.Register(voc.Namespace{Prefix: "rdf:", Full: "http://www.w3.org/1999/02/22-rdf-syntax-ns#"})
+	return &ns
+}
+
+func TestWriter(t *testing.T) {
+	buf := bytes.NewBuffer(nil)
+	for _, c := range writeTests {
+		t.Run(c.name, func(t *testing.T) {
+			buf.Reset()
+			w := turtle.NewWriter(buf, newNamespaces())
+			n, err := quad.Copy(w, quad.NewReader(c.quads))
+			require.NoError(t, err, "write failed after %d quads", n)
+			require.NoError(t, w.Close())
+			require.Equal(t, c.data, buf.String())
+
+			quads, err := quad.ReadAll(turtle.NewReader(buf))
+			require.NoError(t, err)
+			require.Equal(t, c.quads, quads)
+		})
+	}
+}
+
+func TestWriterShortIRIs(t *testing.T) {
+	ns := newNamespaces()
+	ns.Register(voc.Namespace{Prefix: "rdfs:", Full: rdfs.NS})
+	buf := bytes.NewBuffer(nil)
+	w := turtle.NewWriter(buf, ns)
+	require.NoError(t, w.WriteQuad(quad.Quad{Subject: iri("alice"), Predicate: quad.IRI(rdf.Type), Object: quad.IRI(rdfs.Class)}))
+	require.NoError(t, w.WriteQuad(quad.Quad{Subject: iri("alice"), Predicate: quad.IRI(rdfs.Label), Object: quad.String("Alice")}))
+	require.NoError(t, w.Close())
+	require.Equal(t, `@prefix ex: <http://example.org/> .
+@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+@prefix rdfs: <http://www.w3.org/2000/01/rdf-schema#> .
+
+ex:alice a rdfs:Class ;
+	rdfs:label "Alice" .
+`, buf.String())
+}
+
+func TestWriterInvalid(t *testing.T) {
+	w := turtle.NewWriter(io.Discard, nil)
+	err := w.WriteQuad(quad.Quad{Subject: quad.String("s"), Predicate: iri("p"), Object: iri("o")})
+	require.EqualError(t, err, `unsupported subject value: "s"`)
+}
+
+func TestTriGReader(t *testing.T) {
+	const data = `@prefix ex: <http://example.org/> .
+ex:a ex:p ex:b .
+{ ex:c ex:p ex:d }
+ex:g1 { ex:a ex:p ex:b . ex:c ex:p [ ex:q ex:e ] . }
+GRAPH _:g2 { ex:a ex:p ex:b }
+[] { ex:a ex:p ex:b }
+[] ex:p ex:o .
+`
+	quads, err := quad.ReadAll(turtle.NewTriGReader(strings.NewReader(data)))
+	require.NoError(t, err)
+	require.Equal(t, []quad.Quad{
+		{Subject: iri("a"), Predicate: iri("p"), Object: iri("b")},
+		{Subject: iri("c"), Predicate: iri("p"), Object: iri("d")},
+		{Subject: iri("a"), Predicate: iri("p"), Object: iri("b"), Label: iri("g1")},
+		{Subject: iri("c"), Predicate: iri("p"), Object: quad.BNode("n1"), Label: iri("g1")},
+		{Subject: quad.BNode("n1"), Predicate: iri("q"), Object: iri("e"), Label: iri("g1")},
+		{Subject: iri("a"), Predicate: iri("p"), Object: iri("b"), Label: quad.BNode("g2")},
+		{Subject: iri("a"), Predicate: iri("p"), Object: iri("b"), Label: quad.BNode("n2")},
+		{Subject: quad.BNode("n3"), Predicate: iri("p"), Object: iri("o")},
+	}, stripGenerated(quads))
+
+	_, err = quad.ReadAll(turtle.NewTriGReader(strings.NewReader(`<g> { <a> <b> <c> `)))
+	require.EqualError(t, err, `line 1: expected '.' or '}': unexpected EOF`)
+}
+
+func TestTriGWriter(t *testing.T) {
+	quads := []quad.Quad{
+		{Subject: iri("a"), Predicate: iri("p"), Object: iri("b")},
+		{Subject: iri("a"), Predicate: iri("p"), Object: iri("c"), Label: iri("g1")},
+		{Subject: iri("a"), Predicate: iri("q"), Object: quad.Int(1), Label: iri("g1")},
+		{Subject: iri("b"), Predicate: iri("p"), Object: iri("c"), Label: iri("g1")},
+		{Subject: iri("a"), Predicate: iri("p"), Object: iri("b"), Label: quad.BNode("g2")},
+		{Subject: iri("c"), Predicate: iri("p"), Object: iri("d")},
+	}
+	const data = `@prefix ex: <http://example.org/> .
+@prefix rdf: <http://www.w3.org/1999/02/22-rdf-syntax-ns#> .
+
+ex:a ex:p ex:b .
+
+ex:g1 {
+	ex:a ex:p ex:c ;
+		ex:q 1 .
+
+	ex:b ex:p ex:c .
+}
+
+_:g2 {
+	ex:a ex:p ex:b .
+}
+
+ex:c ex:p ex:d .
+`
+	buf := bytes.NewBuffer(nil)
+	w := turtle.NewTriGWriter(buf, newNamespaces())
+	_, err := quad.Copy(w, quad.NewReader(quads))
+	require.NoError(t, err)
+	require.NoError(t, w.Close())
+	require.Equal(t, data, buf.String())
+
+	got, err := quad.ReadAll(turtle.NewTriGReader(buf))
+	require.NoError(t, err)
+	require.Equal(t, quads, got)
+
+	err = w.WriteQuad(quad.Quad{Subject: iri("a"), Predicate: iri("p"), Object: iri("b"), Label: quad.String("g")})
+	require.EqualError(t, err, `unsupported label value: "g"`)
+}
@@ -0,0 +1,324 @@
+package turtle
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/cayleygraph/quad"
+	"github.com/cayleygraph/quad/voc"
+)
+
+var _ quad.WriteCloser = (*Writer)(nil)
+
+// NewWriter returns a Turtle encoder that writes its output to the provided io.Writer.
+//
+// IRIs are compacted using namespaces from ns, or from the global voc registry if ns is nil.
+// All namespaces are declared with @prefix directives at the beginning of the document.
+func NewWriter(w io.Writer, ns *voc.Namespaces) *Writer {
+	var list []voc.Namespace
+	if ns != nil {
+		list = ns.List()
+	} else {
+		list = voc.List()
+	}
+	return &Writer{bw: bufio.NewWriter(w), ns: newPrefixes(list)}
+}
+
+// NewTriGWriter returns a TriG encoder that writes its output to the provided io.Writer.
+//
+// Namespaces are handled the same way as in NewWriter.
+func NewTriGWriter(w io.Writer, ns *voc.Namespaces) *Writer {
+	enc := NewWriter(w, ns)
+	enc.trig = true
+	return enc
+}
+
+// Writer implements Turtle document generator according to the RDF 1.1 Turtle specification.
+// It can also generate TriG documents, if created with NewTriGWriter.
+//
+// Consecutive quads with the same subject and predicate are grouped using ';' and ','.
+// In TriG mode consecutive quads with the same label are grouped into a single graph block.
+// Quad labels are ignored in Turtle mode, since Turtle has no support for named graphs.
+type Writer struct {
+	bw      *bufio.Writer
+	ns      prefixes
+	written bool
+	err     error
+
+	trig   bool
+	label  quad.Value
+	indent string
+	any    bool // any statements were written
+
+	s, p quad.Value
+}
+
+func (w *Writer) writeString(s string) {
+	if w.err != nil {
+		return
+	}
+	_, w.err = w.bw.WriteString(s)
+}
+
+func (w *Writer) writeHeader() {
+	w.written = true
+	w.ns.writeTo(w)
+}
+
+// endStatement finishes the current set of triples, if any.
+func (w *Writer) endStatement() {
+	if w.s != nil {
+		w.writeString(" .\n")
+		w.s, w.p = nil, nil
+	}
+}
+
+// setGraph closes the current graph block and starts a new one for a given label.
+func (w *Writer) setGraph(label quad.Value) {
+	w.endStatement()
+	if w.label != nil {
+		w.writeString("}\n")
+	}
+	if w.any {
+		w.writeString("\n")
+	}
+	w.label, w.indent = label, ""
+	if label != nil {
+		w.writeString(w.ns.term(label))
+		w.writeString(" {\n")
+		w.indent = "\t"
+	}
+}
+
+// WriteQuad implements quad.Writer.
+func (w *Writer) WriteQuad(q quad.Quad) error {
+	if w.err != nil {
+		return w.err
+	} else if !q.IsValid() {
+		return quad.ErrInvalid
+	}
+	if !w.written {
+		w.writeHeader()
+	}
+	if err := w.check(q); err != nil {
+		return err
+	}
+	if w.trig && q.Label != w.label {
+		w.setGraph(q.Label)
+	}
+	switch {
+	case w.s != nil && q.Subject == w.s && q.Predicate == w.p:
+		w.writeString(", ")
+	case w.s != nil && q.Subject == w.s:
+		w.writeString(" ;\n\t" + w.indent)
+		w.writeString(w.ns.predicate(q.Predicate))
+		w.writeString(" ")
+	default:
+		if w.s != nil {
+			w.endStatement()
+			w.writeString("\n")
+		}
+		w.writeString(w.indent)
+		w.writeString(w.ns.term(q.Subject))
+		w.writeString(" ")
+		w.writeString(w.ns.predicate(q.Predicate))
+		w.writeString(" ")
+	}
+	w.s, w.p, w.any = q.Subject, q.Predicate, true
+	w.writeString(w.ns.term(q.Object))
+	return w.err
+}
+
+// WriteQuads implements quad.Writer.
+func (w *Writer) WriteQuads(buf []quad.Quad) (int, error) {
+	for i, q := range buf {
+		if err := w.WriteQuad(q); err != nil {
+			return i, err
+		}
+	}
+	return len(buf), nil
+}
+
+// Close finishes the last statement and flushes the output.
+func (w *Writer) Close() error {
+	if w.err != nil {
+		return w.err
+	}
+	if !w.written {
+		w.writeHeader()
+	}
+	w.endStatement()
+	if w.label != nil {
+		w.writeString("}\n")
+		w.label = nil
+	}
+	if w.err == nil {
+		w.err = w.bw.Flush()
+	}
+	return w.err
+}
+
+// check verifies that the quad can be represented in Turtle or TriG.
+func (w *Writer) check(q quad.Quad) error {
+	switch q.Subject.(type) {
+	case quad.IRI, quad.BNode:
+	default:
+		return fmt.Errorf("unsupported subject value: %v", q.Subject)
+	}
+	if _, ok := q.Predicate.(quad.IRI); !ok {
+		return fmt.Errorf("unsupported predicate value: %v", q.Predicate)
+	}
+	if w.trig {
+		switch q.Label.(type) {
+		case nil, quad.IRI, quad.BNode:
+		default:
+			return fmt.Errorf("unsupported label value: %v", q.Label)
+		}
+	}
+	return nil
+}
+
+// prefixes is a list of namespaces used to compact IRIs, sorted from the longest to the shortest IRI.
+type prefixes []voc.Namespace
+
+func newPrefixes(list []voc.Namespace) prefixes {
+	out := make(prefixes, 0, len(list))
+	for _, ns := range list {
+		if ns.Full == "" || !strings.HasSuffix(ns.Prefix, ":") || !isPrefixName(ns.Prefix[:len(ns.Prefix)-1]) {
+			continue
+		}
+		out = append(out, ns)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if len(out[i].Full) != len(out[j].Full) {
+			return len(out[i].Full) > len(out[j].Full)
+		}
+		return out[i].Prefix < out[j].Prefix
+	})
+	return out
+}
+
+// writeTo writes @prefix directives sorted by the prefix name.
+func (p prefixes) writeTo(w *Writer) {
+	if len(p) == 0 {
+		return
+	}
+	list := make([]voc.Namespace, len(p))
+	copy(list, p)
+	sort.Slice(list, func(i, j int) bool { return list[i].Prefix < list[j].Prefix })
+	for _, ns := range list {
+		w.writeString("@prefix " + ns.Prefix + " " + escapeIRI(ns.Full) + " .\n")
+	}
+	w.writeString("\n")
+}
+
+// iri returns a prefixed name for IRI, if possible, or a full IRI in angle brackets.
+func (p prefixes) iri(v quad.IRI) string {
+	s := string(v.Full())
+	for _, ns := range p {
+		if strings.HasPrefix(s, ns.Full) && isLocalName(s[len(ns.Full):]) {
+			return ns.Prefix + s[len(ns.Full):]
+		}
+	}
+	return escapeIRI(s)
+}
+
+func (p prefixes) predicate(v quad.Value) string {
+	if iri, ok := v.(quad.IRI); ok && iri.Full() == rdfType {
+		return "a"
+	}
+	return p.term(v)
+}
+
+// term formats a quad value using Turtle syntax.
+func (p prefixes) term(v quad.Value) string {
+	switch v := v.(type) {
+	case quad.IRI:
+		return p.iri(v)
+	case quad.BNode, quad.String, quad.LangString:
+		return v.String()
+	case quad.TypedString:
+		return v.Value.String() + "^^" + p.iri(v.Type)
+	case quad.Int:
+		return strconv.FormatInt(int64(v), 10)
+	case quad.Float:
+		f := float64(v)
+		switch {
+		case math.IsNaN(f):
+			return p.term(quad.TypedString{Value: "NaN", Type: xsdDouble})
+		case math.IsInf(f, 1):
+			return p.term(quad.TypedString{Value: "INF", Type: xsdDouble})
+		case math.IsInf(f, -1):
+			return p.term(quad.TypedString{Value: "-INF", Type: xsdDouble})
+		}
+		return strconv.FormatFloat(f, 'E', -1, 64)
+	case quad.Bool:
+		if v {
+			return "true"
+		}
+		return "false"
+	case quad.TypedStringer:
+		ts := v.TypedString()
+		ts.Type = ts.Type.Full()
+		return p.term(ts)
+	}
+	return v.String()
+}
+
+func escapeIRI(s string) string {
+	var sb strings.Builder
+	sb.Grow(len(s) + 2)
+	sb.WriteByte('<')
+	for _, c := range s {
+		switch c {
+		case '<', '>', '"', '{', '}', '|', '^', '`', '\\':
+			fmt.Fprintf(&sb, `\u%04X`, c)
+		default:
+			if c <= 0x20 {
+				fmt.Fprintf(&sb, `\u%04X`, c)
+			} else {
+				sb.WriteRune(c)
+			}
+		}
+	}
+	sb.WriteByte('>')
+	return sb.String()
+}
+
+// isPrefixName checks if a string can be used as a prefix name without escaping.
+func isPrefixName(s string) bool {
+	for i, c := range s {
+		switch {
+		case unicode.IsLetter(c):
+		case i == 0:
+			return false
+		case unicode.IsDigit(c), c == '_', c == '-':
+		case c == '.' && i != len(s)-1:
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// isLocalName checks if a string can be used as a local part of a prefixed name without escaping.
+func isLocalName(s string) bool {
+	for i, c := range s {
+		switch {
+		case unicode.IsLetter(c), unicode.IsDigit(c), c == '_':
+		case i == 0:
+			return false
+		case c == '-':
+		case c == '.' && i != len(s)-1:
+		default:
+			return false
+		}
+	}
+	return true
+}
@@ -26,6 +26,8 @@ func IsValidValue(v Value) bool {
 		return v != ""
 	case BNode:
 		return v != ""
+	case Triple:
+		return v.IsValid()
 	}
 	return true
 }
@@ -328,6 +330,31 @@ func (s BNode) GoString() string {
 }
 func (s BNode) Native() interface{} { return s }
 
+// Triple is an RDF-star quoted triple (ex: << <s> <p> <o> >>).
+//
+// It can be used as a subject or an object of a quad to make statements about other statements.
+type Triple struct {
+	Subject   Value
+	Predicate Value
+	Object    Value
+}
+
+// IsValid checks if all directions of the triple are valid.
+func (s Triple) IsValid() bool {
+	return IsValidValue(s.Subject) && IsValidValue(s.Predicate) && IsValidValue(s.Object)
+}
+
+// String prints the triple in "<< s p o >>" form.
+func (s Triple) String() string {
+	return `<< ` + StringOf(s.Subject) + ` ` + StringOf(s.Predicate) + ` ` + StringOf(s.Object) + ` >>`
+}
+func (s Triple) GoString() string {
+	return fmt.Sprintf("quad.Triple{Subject: %#v, Predicate: %#v, Object: %#v}", s.Subject, s.Predicate, s.Object)
+}
+
+// Native returns a Triple value unchanged.
+func (s Triple) Native() interface{} { return s }
+
 // Native support for basic types
 
 // StringConversion is a function to convert string values with a